@@ -87,12 +87,19 @@ Examples:
 			return fmt.Errorf("Authentication failed: %w", err)
 		}
 
+		// An api-key takes precedence over the session token, a stale one would
+		// hide this login, so the credential saved last is the one used
+		hadAPIKey := cfg.APIKey != ""
 		cfg.Token = token
+		cfg.APIKey = ""
 		if err := config.Save(cfg); err != nil {
 			return fmt.Errorf("failed to save token: %w", err)
 		}
 
 		fmt.Println("Authentication successful! Token saved.")
+		if hadAPIKey {
+			fmt.Println("The saved api-key was removed, the new session token is used instead.")
+		}
 		return nil
 	},
 }
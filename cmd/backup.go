@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var backupCmd = &cobra.Command{
+	Use:   "backup",
+	Short: "Back up and restore the Portainer configuration",
+	Long:  `Create and restore backups of the Portainer configuration and inspect the S3 backup settings`,
+}
+
+func init() {
+	backupCmd.AddCommand(backupCreateCmd)
+	backupCmd.AddCommand(backupRestoreCmd)
+	backupCmd.AddCommand(backupS3Cmd)
+}
+
+func readBackupPassword(cmd *cobra.Command, password string, fromStdin bool) (string, error) {
+	if !fromStdin {
+		return password, nil
+	}
+	if password != "" {
+		return "", fmt.Errorf("use only one of --password or --password-stdin")
+	}
+
+	data, err := io.ReadAll(cmd.InOrStdin())
+	if err != nil {
+		return "", fmt.Errorf("failed to read password from stdin: %w", err)
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
+
+func fileSHA256(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func formatChecksumLine(sum string, name string) string {
+	// Same layout as sha256sum, so the file can be checked with 'sha256sum -c'
+	return fmt.Sprintf("%s  %s\n", sum, name)
+}
+
+func parseChecksumFile(data string) (string, error) {
+	fields := strings.Fields(data)
+	if len(fields) == 0 || len(fields[0]) != sha256.Size*2 {
+		return "", fmt.Errorf("invalid checksum file")
+	}
+	if _, err := hex.DecodeString(fields[0]); err != nil {
+		return "", fmt.Errorf("invalid checksum file")
+	}
+	return strings.ToLower(fields[0]), nil
+}
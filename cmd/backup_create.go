@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var (
+	backupCreateFile          string
+	backupCreatePassword      string
+	backupCreatePasswordStdin bool
+)
+
+var backupCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Download a backup of the Portainer configuration",
+	Long: `Download a tar.gz backup of the Portainer configuration (admin only).
+
+The archive is written to the file given with --file, to the file name chosen
+by the server when --file is omitted, or to stdout with --file -. While it is
+downloaded the archive is checked for truncation and, when it is not encrypted,
+for gzip integrity. Its SHA-256 checksum is stored next to it in <file>.sha256
+(sha256sum format) and verified by 'portainer backup restore'. When writing to
+stdout the checksum is printed to stderr instead.
+
+Examples:
+  # Nightly backup from cron
+  portainer backup create --file /backups/portainer-$(date +%F).tar.gz
+
+  # Encrypted backup, the password is read from stdin
+  echo "$BACKUP_PASSWORD" | portainer backup create --file portainer.tar.gz.encrypted --password-stdin
+
+  # Stream the archive to another tool
+  portainer backup create --file - | aws s3 cp - s3://backups/portainer.tar.gz`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		password, err := readBackupPassword(cmd, backupCreatePassword, backupCreatePasswordStdin)
+		if err != nil {
+			return err
+		}
+
+		cl, _, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		request := types.BackupRequest{Password: password}
+
+		if backupCreateFile == "-" {
+			archive, err := cl.CreateBackup(cmd.Context(), request, cmd.OutOrStdout())
+			if err != nil {
+				return apiError(err, "create backup", "backup")
+			}
+			fmt.Fprintf(cmd.ErrOrStderr(), "SHA256: %s\n", archive.SHA256)
+			return nil
+		}
+
+		dir := "."
+		if backupCreateFile != "" {
+			dir = filepath.Dir(backupCreateFile)
+		}
+
+		// Downloading into a temporary file keeps a previous backup with the
+		// same name intact when the transfer fails
+		tmp, err := os.CreateTemp(dir, ".portainer-backup-*")
+		if err != nil {
+			return fmt.Errorf("failed to create backup file: %w", err)
+		}
+		defer os.Remove(tmp.Name())
+
+		archive, err := cl.CreateBackup(cmd.Context(), request, tmp)
+		if closeErr := tmp.Close(); err == nil && closeErr != nil {
+			return fmt.Errorf("failed to write backup file: %w", closeErr)
+		}
+		if err != nil {
+			return apiError(err, "create backup", "backup")
+		}
+
+		sum, err := fileSHA256(tmp.Name())
+		if err != nil {
+			return fmt.Errorf("failed to verify backup file: %w", err)
+		}
+		if sum != archive.SHA256 {
+			return fmt.Errorf("backup file checksum mismatch: downloaded %s, written %s", archive.SHA256, sum)
+		}
+
+		target := backupCreateFile
+		if target == "" {
+			target = filepath.Base(archive.Filename)
+		}
+		if target == "" || target == "." || target == "/" {
+			target = "portainer-backup.tar.gz"
+		}
+
+		if err := os.Rename(tmp.Name(), target); err != nil {
+			return fmt.Errorf("failed to save backup file: %w", err)
+		}
+		if err := os.WriteFile(target+".sha256", []byte(formatChecksumLine(sum, filepath.Base(target))), 0o644); err != nil {
+			return fmt.Errorf("backup saved to %s but the checksum file could not be written: %w", target, err)
+		}
+
+		encrypted := ""
+		if archive.Encrypted {
+			encrypted = ", encrypted"
+		}
+		fmt.Printf("Backup saved to %s (%d bytes%s)\n", target, archive.Size, encrypted)
+		fmt.Printf("SHA256: %s\n", sum)
+
+		return nil
+	},
+}
+
+func init() {
+	backupCreateCmd.Flags().StringVarP(&backupCreateFile, "file", "f", "", "File to write the archive to (- for stdout, defaults to the name chosen by the server)")
+	backupCreateCmd.Flags().StringVar(&backupCreatePassword, "password", "", "Encrypt the archive with this password")
+	backupCreateCmd.Flags().BoolVar(&backupCreatePasswordStdin, "password-stdin", false, "Read the encryption password from stdin")
+}
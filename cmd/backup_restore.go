@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pdrhp/portainer-go-cli/internal/client"
+	"github.com/spf13/cobra"
+)
+
+var (
+	backupRestorePassword      string
+	backupRestorePasswordStdin bool
+	backupRestoreSHA256        string
+)
+
+var backupRestoreCmd = &cobra.Command{
+	Use:   "restore <file>",
+	Short: "Restore a backup of the Portainer configuration",
+	Long: `Upload a backup archive created by 'portainer backup create' to Portainer.
+
+Portainer only accepts a restore on a freshly installed instance that has not
+been initialized with an administrator yet, so no credentials are required.
+
+Before the upload the SHA-256 checksum of the archive is compared with --sha256
+or, when that flag is omitted, with <file>.sha256 if that file exists.
+
+Examples:
+  # Restore a backup on a new instance
+  portainer backup restore /backups/portainer-2026-10-18.tar.gz --server-url https://portainer.new:9443
+
+  # Restore an encrypted backup, the password is read from stdin
+  echo "$BACKUP_PASSWORD" | portainer backup restore portainer.tar.gz.encrypted --password-stdin
+
+  # Verify against a known checksum
+  portainer backup restore portainer.tar.gz --sha256 9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		path := args[0]
+
+		password, err := readBackupPassword(cmd, backupRestorePassword, backupRestorePasswordStdin)
+		if err != nil {
+			return err
+		}
+
+		expected := strings.ToLower(backupRestoreSHA256)
+		if expected == "" {
+			data, err := os.ReadFile(path + ".sha256")
+			if err == nil {
+				expected, err = parseChecksumFile(string(data))
+				if err != nil {
+					return fmt.Errorf("%s.sha256: %w", path, err)
+				}
+			} else if !errors.Is(err, os.ErrNotExist) {
+				return fmt.Errorf("failed to read checksum file: %w", err)
+			}
+		}
+
+		if expected != "" {
+			sum, err := fileSHA256(path)
+			if err != nil {
+				return fmt.Errorf("failed to read backup file: %w", err)
+			}
+			if sum != expected {
+				return fmt.Errorf("checksum mismatch for %s: expected %s, got %s", path, expected, sum)
+			}
+			fmt.Println("Checksum verified")
+		} else {
+			fmt.Println("No checksum to verify against, uploading as is")
+		}
+
+		cl, _, err := newPublicClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		file, err := os.Open(path)
+		if err != nil {
+			return fmt.Errorf("failed to open backup file: %w", err)
+		}
+		defer file.Close()
+
+		fmt.Printf("Restoring backup from %s...\n", path)
+
+		if err := cl.RestoreBackup(cmd.Context(), file, filepath.Base(path), password); err != nil {
+			var httpErr *client.HTTPError
+			if errors.As(err, &httpErr) && (httpErr.StatusCode == 403 || httpErr.StatusCode == 409) {
+				return fmt.Errorf("Restore rejected: %s. Portainer only restores on a freshly installed instance", strings.TrimSpace(httpErr.Message))
+			}
+			return apiError(err, "restore backup", "backup")
+		}
+
+		fmt.Println("Backup restored. Portainer restarts to apply it, then log in with 'portainer auth'")
+
+		return nil
+	},
+}
+
+func init() {
+	backupRestoreCmd.Flags().StringVar(&backupRestorePassword, "password", "", "Password the archive was encrypted with")
+	backupRestoreCmd.Flags().BoolVar(&backupRestorePasswordStdin, "password-stdin", false, "Read the encryption password from stdin")
+	backupRestoreCmd.Flags().StringVar(&backupRestoreSHA256, "sha256", "", "Expected SHA-256 checksum of the archive (defaults to <file>.sha256)")
+}
@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/internal/client"
+	"github.com/pdrhp/portainer-go-cli/internal/printer"
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var backupS3Cmd = &cobra.Command{
+	Use:   "s3",
+	Short: "Show the scheduled S3 backup settings",
+	Long: `Show the settings and the status of the last run of the scheduled S3 backup.
+Secrets are masked. S3 backups are only available on Portainer Business Edition.
+
+Examples:
+  # Show the S3 backup settings
+  portainer backup s3
+
+  # As JSON
+  portainer backup s3 -o json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, _, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		settings, err := cl.GetBackupS3Settings(cmd.Context())
+		if err != nil {
+			return backupS3Error(err, "get S3 backup settings")
+		}
+
+		status, err := cl.GetBackupS3Status(cmd.Context())
+		if err != nil {
+			return backupS3Error(err, "get S3 backup status")
+		}
+
+		return printer.PrintBackupS3(types.BackupS3Details{Settings: settings.Masked(), Status: *status}, cmd.Flag("output").Value.String())
+	},
+}
+
+func backupS3Error(err error, action string) error {
+	var httpErr *client.HTTPError
+	if errors.As(err, &httpErr) && httpErr.StatusCode == 404 {
+		return fmt.Errorf("S3 backups are not available on this server, they require Portainer Business Edition")
+	}
+	return apiError(err, action, "S3 backup settings")
+}
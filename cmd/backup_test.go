@@ -0,0 +1,34 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChecksumFileRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "backup.tar.gz")
+	require.NoError(t, os.WriteFile(path, []byte("test"), 0o600))
+
+	sum, err := fileSHA256(path)
+	require.NoError(t, err)
+	assert.Equal(t, "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08", sum)
+
+	line := formatChecksumLine(sum, "backup.tar.gz")
+	assert.Equal(t, sum+"  backup.tar.gz\n", line)
+
+	parsed, err := parseChecksumFile(line)
+	require.NoError(t, err)
+	assert.Equal(t, sum, parsed)
+}
+
+func TestParseChecksumFileInvalid(t *testing.T) {
+	_, err := parseChecksumFile("")
+	assert.Error(t, err)
+
+	_, err = parseChecksumFile("abc123  backup.tar.gz")
+	assert.Error(t, err)
+}
@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pdrhp/portainer-go-cli/internal/client"
+	"github.com/pdrhp/portainer-go-cli/internal/config"
+	"github.com/spf13/cobra"
+)
+
+func newAPIClient(cmd *cobra.Command) (*client.Client, *config.Config, error) {
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load config: %w", err)
+	}
+
+	if cfg.Token == "" && cfg.APIKey == "" {
+		return nil, nil, fmt.Errorf("not authenticated. Please run 'portainer auth' first or set an api-key")
+	}
+
+	serverURL := cmd.Flag("server-url").Value.String()
+	if serverURL == "" {
+		serverURL = cfg.ServerURL
+	}
+	if serverURL == "" {
+		return nil, nil, fmt.Errorf("server URL not configured. Use --server-url flag or set it in config")
+	}
+
+	cl := client.New(serverURL)
+	cl.SetToken(cfg.Token)
+	// An API key does not expire, so it wins over a possibly stale session token.
+	// 'portainer auth' removes the api-key, the credential saved last is used.
+	cl.SetAPIKey(cfg.APIKey)
+
+	return cl, cfg, nil
+}
+
+func newPublicClient(cmd *cobra.Command) (*client.Client, *config.Config, error) {
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load config: %w", err)
+	}
+
+	serverURL := cmd.Flag("server-url").Value.String()
+	if serverURL == "" {
+		serverURL = cfg.ServerURL
+	}
+	if serverURL == "" {
+		return nil, nil, fmt.Errorf("server URL not configured. Use --server-url flag or set it in config")
+	}
+
+	// Public endpoints such as /api/status and /api/restore work without
+	// credentials, they are only sent when configured
+	cl := client.New(serverURL)
+	cl.SetToken(cfg.Token)
+	cl.SetAPIKey(cfg.APIKey)
+
+	return cl, cfg, nil
+}
+
+func resolveEndpointID(cmd *cobra.Command, cl *client.Client, cfg *config.Config) (int, error) {
+	ref := cmd.Flag("endpoint").Value.String()
+	if ref == "" {
+		ref = cfg.DefaultEndpoint
+	}
+	if ref == "" {
+		return 0, nil
+	}
+
+	return lookupEndpointID(cmd.Context(), cl, ref)
+}
+
+func requireEndpointID(cmd *cobra.Command, cl *client.Client, cfg *config.Config) (int, error) {
+	endpointID, err := resolveEndpointID(cmd, cl, cfg)
+	if err != nil {
+		return 0, err
+	}
+	if endpointID == 0 {
+		return 0, fmt.Errorf("endpoint not specified. Use --endpoint flag or set default-endpoint in config")
+	}
+
+	return endpointID, nil
+}
+
+func newNodeClient(cmd *cobra.Command, node string) (*client.Client, int, error) {
+	cl, cfg, err := newAPIClient(cmd)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	endpointID, err := requireEndpointID(cmd, cl, cfg)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if node != "" {
+		cl = cl.ForNode(node)
+	}
+
+	return cl, endpointID, nil
+}
+
+func lookupEndpointID(ctx context.Context, cl *client.Client, ref string) (int, error) {
+	if id, err := strconv.Atoi(ref); err == nil {
+		return id, nil
+	}
+
+	endpoint, err := cl.FindEndpointByName(ctx, ref)
+	if err != nil {
+		return 0, apiError(err, "resolve endpoint", "endpoint")
+	}
+
+	return endpoint.ID, nil
+}
+
+func apiError(err error, action string, resource string) error {
+	var httpErr *client.HTTPError
+	if errors.As(err, &httpErr) {
+		switch httpErr.StatusCode {
+		case 400:
+			return fmt.Errorf("Invalid request: %s", httpErr.Message)
+		case 401:
+			return fmt.Errorf("Authentication failed. Please run 'portainer auth' again")
+		case 403:
+			return fmt.Errorf("Permission denied. You don't have access to this %s", resource)
+		case 404:
+			return fmt.Errorf("%s not found", capitalize(resource))
+		default:
+			return fmt.Errorf("Failed to %s (HTTP %d): %s", action, httpErr.StatusCode, httpErr.Message)
+		}
+	}
+	return fmt.Errorf("Failed to %s: %w", action, err)
+}
+
+func capitalize(value string) string {
+	if value == "" {
+		return value
+	}
+	return strings.ToUpper(value[:1]) + value[1:]
+}
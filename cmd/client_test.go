@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pdrhp/portainer-go-cli/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookupEndpointID_NumericReference(t *testing.T) {
+	cl := client.New("http://127.0.0.1:0")
+
+	endpointID, err := lookupEndpointID(context.Background(), cl, "12")
+
+	require.NoError(t, err)
+	assert.Equal(t, 12, endpointID)
+}
+
+func TestLookupEndpointID_NameReference(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"Id":3,"Name":"staging-swarm"},{"Id":5,"Name":"prod-swarm"}]`))
+	}))
+	defer server.Close()
+
+	cl := client.New(server.URL)
+	cl.SetToken("test-token")
+
+	endpointID, err := lookupEndpointID(context.Background(), cl, "prod-swarm")
+
+	require.NoError(t, err)
+	assert.Equal(t, 5, endpointID)
+}
+
+func TestAPIError_StatusMessages(t *testing.T) {
+	tests := []struct {
+		statusCode int
+		expected   string
+	}{
+		{400, "Invalid request: bad"},
+		{401, "Authentication failed. Please run 'portainer auth' again"},
+		{403, "Permission denied. You don't have access to this endpoint"},
+		{404, "Endpoint not found"},
+		{500, "Failed to list endpoints (HTTP 500): bad"},
+	}
+
+	for _, test := range tests {
+		err := apiError(&client.HTTPError{StatusCode: test.statusCode, Message: "bad"}, "list endpoints", "endpoint")
+		assert.EqualError(t, err, test.expected)
+	}
+}
@@ -21,7 +21,8 @@ var configSetCmd = &cobra.Command{
 - server-url: Portainer server URL
 - username: Default username for authentication
 - password: Default password for authentication
-- api-key: API key for authentication`,
+- api-key: API key for authentication
+- default-endpoint: Environment name or ID used when --endpoint is omitted`,
 	Args: cobra.ExactArgs(2),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		key := args[0]
@@ -41,6 +42,8 @@ var configSetCmd = &cobra.Command{
 			cfg.Password = value
 		case "api-key", "api_key":
 			cfg.APIKey = value
+		case "default-endpoint", "default_endpoint":
+			cfg.DefaultEndpoint = value
 		default:
 			return fmt.Errorf("unknown config key: %s", key)
 		}
@@ -71,6 +74,7 @@ var configGetCmd = &cobra.Command{
 			fmt.Printf("Password: %s\n", maskValue(cfg.Password))
 			fmt.Printf("API Key: %s\n", maskValue(cfg.APIKey))
 			fmt.Printf("Token: %s\n", maskValue(cfg.Token))
+			fmt.Printf("Default Endpoint: %s\n", cfg.DefaultEndpoint)
 		} else {
 			key := args[0]
 			switch key {
@@ -84,6 +88,8 @@ var configGetCmd = &cobra.Command{
 				fmt.Println(maskValue(cfg.APIKey))
 			case "token":
 				fmt.Println(maskValue(cfg.Token))
+			case "default-endpoint", "default_endpoint":
+				fmt.Println(cfg.DefaultEndpoint)
 			default:
 				return fmt.Errorf("unknown config key: %s", key)
 			}
@@ -0,0 +1,18 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var configsCmd = &cobra.Command{
+	Use:   "configs",
+	Short: "Manage swarm configs",
+	Long:  `Manage Docker Swarm configs of a swarm environment through the Portainer Docker proxy`,
+}
+
+func init() {
+	configsCmd.AddCommand(configsListCmd)
+	configsCmd.AddCommand(configsInspectCmd)
+	configsCmd.AddCommand(configsCreateCmd)
+	configsCmd.AddCommand(configsRmCmd)
+}
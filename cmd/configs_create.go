@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var (
+	configsCreateFile   string
+	configsCreateValue  string
+	configsCreateLabels []string
+)
+
+var configsCreateCmd = &cobra.Command{
+	Use:   "create [name]",
+	Short: "Create a config",
+	Long: `Create a swarm config from a file, stdin or a literal value.
+
+Examples:
+  # Create a config from a file
+  portainer configs create nginx_conf.v2 --file ./nginx.conf
+
+  # Create a config from stdin
+  envsubst < app.yml.tpl | portainer configs create app_settings --file -
+
+  # Create a config from a literal value
+  portainer configs create feature_flags --value '{"beta":true}' --label team=web`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		data, err := readObjectData(cmd, configsCreateFile, configsCreateValue)
+		if err != nil {
+			return err
+		}
+
+		labels, err := parseKeyValueFlags(configsCreateLabels, "label")
+		if err != nil {
+			return err
+		}
+
+		cl, cfg, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		endpointID, err := requireEndpointID(cmd, cl, cfg)
+		if err != nil {
+			return err
+		}
+
+		id, err := cl.CreateConfig(cmd.Context(), endpointID, types.SwarmConfigSpec{
+			Name:   args[0],
+			Labels: labels,
+			Data:   data,
+		})
+		if err != nil {
+			return apiError(err, "create config", "endpoint")
+		}
+
+		fmt.Printf("Config %s created successfully (ID: %s)\n", args[0], id)
+		return nil
+	},
+}
+
+func init() {
+	configsCreateCmd.Flags().StringVar(&configsCreateFile, "file", "", "Read the config from a file (- for stdin)")
+	configsCreateCmd.Flags().StringVar(&configsCreateValue, "value", "", "Literal config content")
+	configsCreateCmd.Flags().StringArrayVar(&configsCreateLabels, "label", []string{}, "Config label (format: KEY=value, can be used multiple times)")
+}
@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"github.com/pdrhp/portainer-go-cli/internal/printer"
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var configsInspectCmd = &cobra.Command{
+	Use:   "inspect [config]",
+	Short: "Show details and content of a config",
+	Long: `Show details of a swarm config referenced by ID or name, including its content
+and the services using it.
+
+Examples:
+  # Inspect a config
+  portainer configs inspect nginx_conf
+
+  # Output in JSON format (Data is base64 encoded)
+  portainer configs inspect nginx_conf --output json`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, cfg, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		endpointID, err := requireEndpointID(cmd, cl, cfg)
+		if err != nil {
+			return err
+		}
+
+		config, err := cl.InspectConfig(cmd.Context(), endpointID, args[0])
+		if err != nil {
+			return apiError(err, "inspect config", "config")
+		}
+
+		services, err := cl.ListServices(cmd.Context(), endpointID, nil)
+		if err != nil {
+			return apiError(err, "list services", "endpoint")
+		}
+
+		details := types.SwarmConfigDetails{
+			SwarmConfig: *config,
+			UsedBy:      serviceNames(configUsers(services, config.ID)),
+		}
+
+		outputFormat := cmd.Flag("output").Value.String()
+
+		return printer.PrintConfig(details, outputFormat)
+	},
+}
@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/internal/printer"
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var configsListStack string
+
+var configsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List configs",
+	Long: `List swarm configs of a swarm environment.
+
+Examples:
+  # List configs of the default endpoint
+  portainer configs list
+
+  # List configs created by a stack
+  portainer configs list --stack web
+
+  # Output in JSON format
+  portainer configs list --output json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, cfg, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		endpointID, err := requireEndpointID(cmd, cl, cfg)
+		if err != nil {
+			return err
+		}
+
+		configs, err := cl.ListConfigs(cmd.Context(), endpointID)
+		if err != nil {
+			return apiError(err, "list configs", "endpoint")
+		}
+
+		if configsListStack != "" {
+			filtered := make([]types.SwarmConfig, 0, len(configs))
+			for _, config := range configs {
+				if config.StackName() == configsListStack {
+					filtered = append(filtered, config)
+				}
+			}
+			configs = filtered
+		}
+
+		if len(configs) == 0 {
+			fmt.Println("No configs found.")
+			return nil
+		}
+
+		outputFormat := cmd.Flag("output").Value.String()
+
+		return printer.PrintConfigs(configs, outputFormat)
+	},
+}
+
+func init() {
+	configsListCmd.Flags().StringVar(&configsListStack, "stack", "", "Filter configs by stack name")
+}
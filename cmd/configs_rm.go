@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var configsRmCmd = &cobra.Command{
+	Use:     "rm [config...]",
+	Aliases: []string{"remove"},
+	Short:   "Remove one or more configs",
+	Long: `Remove one or more swarm configs referenced by ID or name. Configs used by a service cannot be removed.
+
+Examples:
+  # Remove configs
+  portainer configs rm nginx_conf.v1 app_settings`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, cfg, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		endpointID, err := requireEndpointID(cmd, cl, cfg)
+		if err != nil {
+			return err
+		}
+
+		failed := 0
+		for _, config := range args {
+			if err := cl.RemoveConfig(cmd.Context(), endpointID, config); err != nil {
+				fmt.Printf("Error: %s: %v\n", config, apiError(err, "remove config", "config"))
+				failed++
+				continue
+			}
+			fmt.Printf("Removed %s\n", config)
+		}
+
+		if failed > 0 {
+			return fmt.Errorf("failed to remove %d of %d configs", failed, len(args))
+		}
+
+		return nil
+	},
+}
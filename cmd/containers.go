@@ -0,0 +1,21 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var containersCmd = &cobra.Command{
+	Use:   "containers",
+	Short: "Manage containers",
+	Long:  `Manage Docker containers of an environment through the Portainer Docker proxy`,
+}
+
+func init() {
+	containersCmd.AddCommand(containersListCmd)
+	containersCmd.AddCommand(containersInspectCmd)
+	containersCmd.AddCommand(containersStartCmd)
+	containersCmd.AddCommand(containersStopCmd)
+	containersCmd.AddCommand(containersRestartCmd)
+	containersCmd.AddCommand(containersKillCmd)
+	containersCmd.AddCommand(containersRmCmd)
+}
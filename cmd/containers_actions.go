@@ -0,0 +1,146 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/internal/client"
+	"github.com/spf13/cobra"
+)
+
+var (
+	containersStopTimeout    int
+	containersRestartTimeout int
+	containersKillSignal     string
+	containersRmForce        bool
+	containersRmVolumes      bool
+)
+
+var containersStartCmd = &cobra.Command{
+	Use:   "start [container...]",
+	Short: "Start one or more containers",
+	Long: `Start one or more stopped containers referenced by ID or name.
+
+Examples:
+  # Start a container
+  portainer containers start my-container
+
+  # Start several containers on a named endpoint
+  portainer containers start web worker --endpoint staging-swarm`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runContainerAction(cmd, args, "start", "Started", func(ctx context.Context, cl *client.Client, endpointID int, containerID string) error {
+			return cl.StartContainer(ctx, endpointID, containerID)
+		})
+	},
+}
+
+var containersStopCmd = &cobra.Command{
+	Use:   "stop [container...]",
+	Short: "Stop one or more containers",
+	Long: `Stop one or more running containers referenced by ID or name.
+
+Examples:
+  # Stop a container
+  portainer containers stop my-container
+
+  # Stop a container giving it 30 seconds to exit
+  portainer containers stop my-container --time 30`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runContainerAction(cmd, args, "stop", "Stopped", func(ctx context.Context, cl *client.Client, endpointID int, containerID string) error {
+			return cl.StopContainer(ctx, endpointID, containerID, containersStopTimeout)
+		})
+	},
+}
+
+var containersRestartCmd = &cobra.Command{
+	Use:   "restart [container...]",
+	Short: "Restart one or more containers",
+	Long: `Restart one or more containers referenced by ID or name.
+
+Examples:
+  # Restart a container
+  portainer containers restart my-container`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runContainerAction(cmd, args, "restart", "Restarted", func(ctx context.Context, cl *client.Client, endpointID int, containerID string) error {
+			return cl.RestartContainer(ctx, endpointID, containerID, containersRestartTimeout)
+		})
+	},
+}
+
+var containersKillCmd = &cobra.Command{
+	Use:   "kill [container...]",
+	Short: "Kill one or more running containers",
+	Long: `Send a signal to one or more running containers.
+
+Examples:
+  # Kill a container
+  portainer containers kill my-container
+
+  # Send SIGHUP to reload configuration
+  portainer containers kill my-container --signal SIGHUP`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runContainerAction(cmd, args, "kill", "Killed", func(ctx context.Context, cl *client.Client, endpointID int, containerID string) error {
+			return cl.KillContainer(ctx, endpointID, containerID, containersKillSignal)
+		})
+	},
+}
+
+var containersRmCmd = &cobra.Command{
+	Use:     "rm [container...]",
+	Aliases: []string{"remove"},
+	Short:   "Remove one or more containers",
+	Long: `Remove one or more containers referenced by ID or name.
+
+Examples:
+  # Remove a stopped container
+  portainer containers rm my-container
+
+  # Force the removal of a running container and its anonymous volumes
+  portainer containers rm my-container --force --volumes`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runContainerAction(cmd, args, "remove", "Removed", func(ctx context.Context, cl *client.Client, endpointID int, containerID string) error {
+			return cl.RemoveContainer(ctx, endpointID, containerID, containersRmForce, containersRmVolumes)
+		})
+	},
+}
+
+func runContainerAction(cmd *cobra.Command, containerIDs []string, action string, done string, fn func(context.Context, *client.Client, int, string) error) error {
+	cl, cfg, err := newAPIClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	endpointID, err := requireEndpointID(cmd, cl, cfg)
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, containerID := range containerIDs {
+		if err := fn(cmd.Context(), cl, endpointID, containerID); err != nil {
+			fmt.Printf("Error: %s: %v\n", containerID, apiError(err, action+" container", "container"))
+			failed++
+			continue
+		}
+		fmt.Printf("%s %s\n", done, containerID)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to %s %d of %d containers", action, failed, len(containerIDs))
+	}
+
+	return nil
+}
+
+func init() {
+	containersStopCmd.Flags().IntVarP(&containersStopTimeout, "time", "t", -1, "Seconds to wait before killing the container (default: Docker default)")
+	containersRestartCmd.Flags().IntVarP(&containersRestartTimeout, "time", "t", -1, "Seconds to wait before killing the container (default: Docker default)")
+	containersKillCmd.Flags().StringVarP(&containersKillSignal, "signal", "s", "", "Signal to send to the container (default: SIGKILL)")
+	containersRmCmd.Flags().BoolVarP(&containersRmForce, "force", "f", false, "Force the removal of a running container")
+	containersRmCmd.Flags().BoolVarP(&containersRmVolumes, "volumes", "v", false, "Remove anonymous volumes associated with the container")
+}
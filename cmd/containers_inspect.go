@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"github.com/pdrhp/portainer-go-cli/internal/printer"
+	"github.com/spf13/cobra"
+)
+
+var containersInspectCmd = &cobra.Command{
+	Use:   "inspect [container]",
+	Short: "Show details of a container",
+	Long: `Show details of a container referenced by ID or name.
+
+Examples:
+  # Inspect a container
+  portainer containers inspect my-stack_web.1.x8k2ld01mzq4
+
+  # Output in JSON format
+  portainer containers inspect 3f2a1b --output json`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, cfg, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		endpointID, err := requireEndpointID(cmd, cl, cfg)
+		if err != nil {
+			return err
+		}
+
+		container, err := cl.InspectContainer(cmd.Context(), endpointID, args[0])
+		if err != nil {
+			return apiError(err, "inspect container", "container")
+		}
+
+		outputFormat := cmd.Flag("output").Value.String()
+
+		return printer.PrintContainer(*container, outputFormat)
+	},
+}
@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/internal/printer"
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var (
+	containersListAll    bool
+	containersListStack  string
+	containersListLabel  []string
+	containersListStatus []string
+)
+
+var containersListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List containers",
+	Long: `List containers of an environment.
+
+Examples:
+  # List running containers of the default endpoint
+  portainer containers list
+
+  # List all containers of a stack, including stopped ones
+  portainer containers list --endpoint prod-swarm --stack my-stack --all
+
+  # Filter by label and status
+  portainer containers list --label com.example.team=payments --status exited
+
+  # Output in JSON format
+  portainer containers list --output json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, cfg, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		endpointID, err := requireEndpointID(cmd, cl, cfg)
+		if err != nil {
+			return err
+		}
+
+		filters := &types.ContainerFilters{
+			All:    containersListAll || len(containersListStatus) > 0,
+			Stack:  containersListStack,
+			Labels: containersListLabel,
+			Status: containersListStatus,
+		}
+
+		containers, err := cl.ListContainers(cmd.Context(), endpointID, filters)
+		if err != nil {
+			return apiError(err, "list containers", "endpoint")
+		}
+
+		if len(containers) == 0 {
+			fmt.Println("No containers found.")
+			return nil
+		}
+
+		outputFormat := cmd.Flag("output").Value.String()
+
+		return printer.PrintContainers(containers, outputFormat)
+	},
+}
+
+func init() {
+	containersListCmd.Flags().BoolVarP(&containersListAll, "all", "a", false, "Show all containers (default shows only running)")
+	containersListCmd.Flags().StringVar(&containersListStack, "stack", "", "Filter containers by stack name")
+	containersListCmd.Flags().StringArrayVar(&containersListLabel, "label", []string{}, "Filter containers by label (format: key or key=value)")
+	containersListCmd.Flags().StringArrayVar(&containersListStatus, "status", []string{}, "Filter containers by status (created|restarting|running|removing|paused|exited|dead)")
+}
@@ -0,0 +1,262 @@
+package cmd
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/pdrhp/portainer-go-cli/internal/printer"
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var (
+	edgeGroupsCreateTags  []string
+	edgeGroupsCreateMatch string
+)
+
+var edgeGroupsCmd = &cobra.Command{
+	Use:   "edge-groups",
+	Short: "Manage edge groups",
+	Long:  `Manage the edge groups targeted by edge stacks and edge jobs`,
+}
+
+func init() {
+	edgeGroupsCreateCmd.Flags().StringArrayVar(&edgeGroupsCreateTags, "tag", []string{}, "Tag selecting the environments of a dynamic group (can be used multiple times)")
+	edgeGroupsCreateCmd.Flags().StringVar(&edgeGroupsCreateMatch, "match", "all", "For dynamic groups, select environments with all or any of the tags")
+
+	edgeGroupsCmd.AddCommand(edgeGroupsListCmd)
+	edgeGroupsCmd.AddCommand(edgeGroupsCreateCmd)
+	edgeGroupsCmd.AddCommand(edgeGroupsAddEndpointCmd)
+	edgeGroupsCmd.AddCommand(edgeGroupsRemoveEndpointCmd)
+}
+
+var edgeGroupsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List edge groups",
+	Long: `List edge groups with their tags and environments.
+
+Examples:
+  # List edge groups
+  portainer edge-groups list
+
+  # Output in JSON format
+  portainer edge-groups list --output json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, _, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		groups, err := cl.ListEdgeGroups(cmd.Context())
+		if err != nil {
+			return apiError(err, "list edge groups", "edge group")
+		}
+
+		if len(groups) == 0 {
+			fmt.Println("No edge groups found.")
+			return nil
+		}
+
+		tags, err := cl.ListTags(cmd.Context())
+		if err != nil {
+			return apiError(err, "list tags", "tag")
+		}
+
+		endpoints, err := cl.ListEndpoints(cmd.Context(), nil)
+		if err != nil {
+			return apiError(err, "list endpoints", "endpoint")
+		}
+
+		outputFormat := cmd.Flag("output").Value.String()
+
+		return printer.PrintEdgeGroups(edgeGroupDetails(groups, tags, endpoints), outputFormat)
+	},
+}
+
+var edgeGroupsCreateCmd = &cobra.Command{
+	Use:   "create [name] [endpoint...]",
+	Short: "Create an edge group",
+	Long: `Create an edge group. Without --tag the group is static and holds the given
+edge environments. With --tag the group is dynamic and holds every edge
+environment carrying all (or with --match any, any) of the tags.
+
+Examples:
+  # Create a static group
+  portainer edge-groups create shops shop-paris shop-lyon
+
+  # Create a dynamic group from tags
+  portainer edge-groups create warehouses --tag warehouse
+
+  # Create a dynamic group matching any of the tags
+  portainer edge-groups create europe --tag france --tag germany --match any`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dynamic := len(edgeGroupsCreateTags) > 0
+		if dynamic && len(args) > 1 {
+			return fmt.Errorf("a dynamic group selects its environments by tags, environments cannot be listed")
+		}
+		if edgeGroupsCreateMatch != "all" && edgeGroupsCreateMatch != "any" {
+			return fmt.Errorf("invalid --match value %q. Use all or any", edgeGroupsCreateMatch)
+		}
+
+		cl, _, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		request := types.EdgeGroupRequest{
+			Name:         args[0],
+			Dynamic:      dynamic,
+			TagIDs:       []int{},
+			Endpoints:    []int{},
+			PartialMatch: edgeGroupsCreateMatch == "any",
+		}
+
+		if dynamic {
+			tags, err := cl.ListTags(cmd.Context())
+			if err != nil {
+				return apiError(err, "list tags", "tag")
+			}
+			for _, ref := range edgeGroupsCreateTags {
+				tag := findTag(tags, ref)
+				if tag == nil {
+					return fmt.Errorf("tag %q not found", ref)
+				}
+				request.TagIDs = append(request.TagIDs, tag.ID)
+			}
+		}
+
+		for _, ref := range args[1:] {
+			endpointID, err := lookupEndpointID(cmd.Context(), cl, ref)
+			if err != nil {
+				return err
+			}
+			request.Endpoints = append(request.Endpoints, endpointID)
+		}
+
+		group, err := cl.CreateEdgeGroup(cmd.Context(), request)
+		if err != nil {
+			return apiError(err, "create edge group", "edge group")
+		}
+
+		fmt.Printf("Edge group '%s' created successfully (ID: %d, %s)\n", group.Name, group.ID, group.Kind())
+		return nil
+	},
+}
+
+var edgeGroupsAddEndpointCmd = &cobra.Command{
+	Use:   "add-endpoint [group] [endpoint...]",
+	Short: "Add edge environments to a static group",
+	Long: `Add edge environments to a static edge group. Edge stacks targeting the group
+are deployed to the new environments.
+
+Examples:
+  # Add environments
+  portainer edge-groups add-endpoint shops shop-nantes shop-lille`,
+	Args: cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return changeEdgeGroupEndpoints(cmd, args[0], args[1:], true)
+	},
+}
+
+var edgeGroupsRemoveEndpointCmd = &cobra.Command{
+	Use:   "remove-endpoint [group] [endpoint...]",
+	Short: "Remove edge environments from a static group",
+	Long: `Remove edge environments from a static edge group. Edge stacks targeting the
+group are removed from these environments.
+
+Examples:
+  # Remove an environment
+  portainer edge-groups remove-endpoint shops shop-lyon`,
+	Args: cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return changeEdgeGroupEndpoints(cmd, args[0], args[1:], false)
+	},
+}
+
+func changeEdgeGroupEndpoints(cmd *cobra.Command, groupRef string, endpointRefs []string, add bool) error {
+	cl, _, err := newAPIClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	groups, err := cl.ListEdgeGroups(cmd.Context())
+	if err != nil {
+		return apiError(err, "list edge groups", "edge group")
+	}
+
+	group := findEdgeGroup(groups, groupRef)
+	if group == nil {
+		return fmt.Errorf("edge group %q not found", groupRef)
+	}
+	if group.Dynamic {
+		return fmt.Errorf("edge group '%s' is dynamic, its environments are selected by tags", group.Name)
+	}
+
+	endpoints := slices.Clone(group.Endpoints)
+	for _, ref := range endpointRefs {
+		endpointID, err := lookupEndpointID(cmd.Context(), cl, ref)
+		if err != nil {
+			return err
+		}
+
+		index := slices.Index(endpoints, endpointID)
+		switch {
+		case add && index < 0:
+			endpoints = append(endpoints, endpointID)
+		case !add && index >= 0:
+			endpoints = slices.Delete(endpoints, index, index+1)
+		case add:
+			fmt.Printf("%s is already in edge group '%s'\n", ref, group.Name)
+		default:
+			fmt.Printf("%s is not in edge group '%s'\n", ref, group.Name)
+		}
+	}
+
+	if slices.Equal(endpoints, group.Endpoints) {
+		return nil
+	}
+
+	_, err = cl.UpdateEdgeGroup(cmd.Context(), group.ID, types.EdgeGroupRequest{
+		Name:         group.Name,
+		Dynamic:      false,
+		TagIDs:       group.TagIDs,
+		Endpoints:    endpoints,
+		PartialMatch: group.PartialMatch,
+	})
+	if err != nil {
+		return apiError(err, "update edge group", "edge group")
+	}
+
+	fmt.Printf("Edge group '%s' now has %d environments\n", group.Name, len(endpoints))
+	return nil
+}
+
+func edgeGroupDetails(groups []types.EdgeGroup, tags []types.Tag, endpoints []types.Endpoint) []types.EdgeGroupDetails {
+	tagNames := make(map[int]string, len(tags))
+	for _, tag := range tags {
+		tagNames[tag.ID] = tag.Name
+	}
+	endpointNames := make(map[int]string, len(endpoints))
+	for _, endpoint := range endpoints {
+		endpointNames[endpoint.ID] = endpoint.Name
+	}
+
+	details := make([]types.EdgeGroupDetails, 0, len(groups))
+	for _, group := range groups {
+		detail := types.EdgeGroupDetails{EdgeGroup: group}
+		for _, tagID := range group.TagIDs {
+			if name, ok := tagNames[tagID]; ok {
+				detail.TagNames = append(detail.TagNames, name)
+			}
+		}
+		for _, endpointID := range group.Endpoints {
+			if name, ok := endpointNames[endpointID]; ok {
+				detail.EndpointNames = append(detail.EndpointNames, name)
+			}
+		}
+		details = append(details, detail)
+	}
+
+	return details
+}
@@ -0,0 +1,264 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pdrhp/portainer-go-cli/internal/client"
+	"github.com/pdrhp/portainer-go-cli/internal/printer"
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var (
+	edgeJobsCreateName       string
+	edgeJobsCreateFile       string
+	edgeJobsCreateCron       string
+	edgeJobsCreateRecurring  bool
+	edgeJobsCreateEdgeGroups []string
+
+	edgeJobsLogsRefresh bool
+	edgeJobsLogsWait    bool
+	edgeJobsLogsTimeout time.Duration
+)
+
+var edgeJobsCmd = &cobra.Command{
+	Use:   "edge-jobs",
+	Short: "Manage edge jobs",
+	Long:  `Run scheduled scripts on edge environments and collect their logs`,
+}
+
+func init() {
+	edgeJobsCreateCmd.Flags().StringVar(&edgeJobsCreateName, "name", "", "Name of the job (required)")
+	edgeJobsCreateCmd.Flags().StringVar(&edgeJobsCreateFile, "file", "", "Script to run (- for stdin, required)")
+	edgeJobsCreateCmd.Flags().StringVar(&edgeJobsCreateCron, "cron", "", "Cron schedule, e.g. \"0 3 * * *\" (required)")
+	edgeJobsCreateCmd.Flags().BoolVar(&edgeJobsCreateRecurring, "recurring", true, "Run the job on every occurrence of the schedule (false runs it once)")
+	edgeJobsCreateCmd.Flags().StringArrayVar(&edgeJobsCreateEdgeGroups, "edge-group", []string{}, "Edge group to run the job on, by name or ID (required, can be used multiple times)")
+
+	edgeJobsLogsCmd.Flags().BoolVar(&edgeJobsLogsRefresh, "refresh", false, "Collect the logs again even when already collected")
+	edgeJobsLogsCmd.Flags().BoolVar(&edgeJobsLogsWait, "wait", false, "Wait for the edge agent to upload the logs")
+	edgeJobsLogsCmd.Flags().DurationVar(&edgeJobsLogsTimeout, "timeout", 5*time.Minute, "Maximum time to wait for the logs with --wait")
+
+	edgeJobsCmd.AddCommand(edgeJobsListCmd)
+	edgeJobsCmd.AddCommand(edgeJobsCreateCmd)
+	edgeJobsCmd.AddCommand(edgeJobsLogsCmd)
+}
+
+var edgeJobsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List edge jobs",
+	Long: `List edge jobs with their schedule and targeted edge groups.
+
+Examples:
+  # List edge jobs
+  portainer edge-jobs list
+
+  # Output in JSON format
+  portainer edge-jobs list --output json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, _, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		jobs, err := cl.ListEdgeJobs(cmd.Context())
+		if err != nil {
+			return apiError(err, "list edge jobs", "edge job")
+		}
+
+		if len(jobs) == 0 {
+			fmt.Println("No edge jobs found.")
+			return nil
+		}
+
+		groups, err := cl.ListEdgeGroups(cmd.Context())
+		if err != nil {
+			return apiError(err, "list edge groups", "edge group")
+		}
+
+		outputFormat := cmd.Flag("output").Value.String()
+
+		return printer.PrintEdgeJobs(edgeJobDetails(jobs, groups), outputFormat)
+	},
+}
+
+var edgeJobsCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create an edge job",
+	Long: `Create an edge job running a script on the environments of one or more edge
+groups, following a cron schedule evaluated by each edge agent.
+
+Examples:
+  # Clean up images every night
+  portainer edge-jobs create --name image-cleanup --file cleanup.sh --cron "0 3 * * *" --edge-group shops
+
+  # Run a script once, at the next occurrence of the schedule
+  portainer edge-jobs create --name collect-diag --file diag.sh --cron "30 14 * * *" --recurring=false --edge-group shops`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if edgeJobsCreateName == "" {
+			return fmt.Errorf("--name is required")
+		}
+		if edgeJobsCreateFile == "" {
+			return fmt.Errorf("--file is required")
+		}
+		if len(strings.Fields(edgeJobsCreateCron)) != 5 {
+			return fmt.Errorf("invalid --cron value %q, expected 5 fields (minute hour day month weekday)", edgeJobsCreateCron)
+		}
+		if len(edgeJobsCreateEdgeGroups) == 0 {
+			return fmt.Errorf("at least one --edge-group is required")
+		}
+
+		script, err := readObjectData(cmd, edgeJobsCreateFile, "")
+		if err != nil {
+			return err
+		}
+
+		cl, _, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		groups, err := cl.ListEdgeGroups(cmd.Context())
+		if err != nil {
+			return apiError(err, "list edge groups", "edge group")
+		}
+
+		groupIDs, err := resolveEdgeGroupIDs(groups, edgeJobsCreateEdgeGroups)
+		if err != nil {
+			return err
+		}
+
+		job, err := cl.CreateEdgeJob(cmd.Context(), types.EdgeJobCreateRequest{
+			Name:           edgeJobsCreateName,
+			CronExpression: edgeJobsCreateCron,
+			Recurring:      edgeJobsCreateRecurring,
+			EdgeGroups:     groupIDs,
+			Endpoints:      []int{},
+			FileContent:    string(script),
+		})
+		if err != nil {
+			return apiError(err, "create edge job", "edge job")
+		}
+
+		fmt.Printf("Edge job '%s' created successfully (ID: %d)\n", job.Name, job.ID)
+		return nil
+	},
+}
+
+var edgeJobsLogsCmd = &cobra.Command{
+	Use:   "logs [job] [endpoint]",
+	Short: "Fetch the logs of an edge job on an environment",
+	Long: `Fetch the output of an edge job on an edge environment.
+
+Logs are uploaded by the edge agent on its next check-in after they are
+requested. When they are not collected yet, the command requests them and,
+with --wait, waits for the upload.
+
+Examples:
+  # Fetch collected logs, or request them
+  portainer edge-jobs logs image-cleanup shop-paris
+
+  # Collect fresh logs and wait for them
+  portainer edge-jobs logs image-cleanup shop-paris --refresh --wait`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, _, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		job, err := lookupEdgeJob(cmd.Context(), cl, args[0])
+		if err != nil {
+			return err
+		}
+
+		endpointID, err := lookupEndpointID(cmd.Context(), cl, args[1])
+		if err != nil {
+			return err
+		}
+
+		tasks, err := cl.ListEdgeJobTasks(cmd.Context(), job.ID)
+		if err != nil {
+			return apiError(err, "list edge job tasks", "edge job")
+		}
+
+		var task *types.EdgeJobTask
+		for i := range tasks {
+			if tasks[i].EndpointID == endpointID {
+				task = &tasks[i]
+			}
+		}
+		if task == nil {
+			return fmt.Errorf("edge job '%s' does not run on %s", job.Name, args[1])
+		}
+
+		if task.LogsStatus != types.EdgeJobLogsStatusCollected || edgeJobsLogsRefresh {
+			if task.LogsStatus != types.EdgeJobLogsStatusPending || edgeJobsLogsRefresh {
+				if err := cl.CollectEdgeJobLogs(cmd.Context(), job.ID, endpointID); err != nil {
+					return apiError(err, "request edge job logs", "edge job")
+				}
+			}
+
+			if !edgeJobsLogsWait {
+				fmt.Printf("Logs requested from %s, run the command again once the edge agent has uploaded them (or use --wait)\n", args[1])
+				return nil
+			}
+
+			ctx, cancel := context.WithTimeout(cmd.Context(), edgeJobsLogsTimeout)
+			defer cancel()
+
+			if err := cl.WaitForEdgeJobLogs(ctx, job.ID, endpointID, 5*time.Second); err != nil {
+				return err
+			}
+		}
+
+		logs, err := cl.GetEdgeJobLogs(cmd.Context(), job.ID, endpointID)
+		if err != nil {
+			return apiError(err, "get edge job logs", "edge job")
+		}
+
+		fmt.Print(logs)
+		return nil
+	},
+}
+
+func lookupEdgeJob(ctx context.Context, cl *client.Client, ref string) (*types.EdgeJob, error) {
+	jobs, err := cl.ListEdgeJobs(ctx)
+	if err != nil {
+		return nil, apiError(err, "list edge jobs", "edge job")
+	}
+
+	id, _ := strconv.Atoi(ref)
+	for i := range jobs {
+		if jobs[i].Name == ref || (id > 0 && jobs[i].ID == id) {
+			return &jobs[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("edge job %q not found", ref)
+}
+
+func edgeJobDetails(jobs []types.EdgeJob, groups []types.EdgeGroup) []types.EdgeJobDetails {
+	groupNames := make(map[int]string, len(groups))
+	for _, group := range groups {
+		groupNames[group.ID] = group.Name
+	}
+
+	details := make([]types.EdgeJobDetails, 0, len(jobs))
+	for _, job := range jobs {
+		detail := types.EdgeJobDetails{EdgeJob: job}
+		for _, groupID := range job.EdgeGroups {
+			name, ok := groupNames[groupID]
+			if !ok {
+				name = strconv.Itoa(groupID)
+			}
+			detail.EdgeGroupNames = append(detail.EdgeGroupNames, name)
+		}
+		details = append(details, detail)
+	}
+
+	return details
+}
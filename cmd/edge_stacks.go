@@ -0,0 +1,142 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/pdrhp/portainer-go-cli/internal/client"
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var edgeStacksCmd = &cobra.Command{
+	Use:   "edge-stacks",
+	Short: "Manage edge stacks",
+	Long:  `Deploy stacks to fleets of edge environments through Portainer edge groups`,
+}
+
+func init() {
+	edgeStacksCmd.AddCommand(edgeStacksListCmd)
+	edgeStacksCmd.AddCommand(edgeStacksInspectCmd)
+	edgeStacksCmd.AddCommand(edgeStacksCreateCmd)
+	edgeStacksCmd.AddCommand(edgeStacksUpdateCmd)
+	edgeStacksCmd.AddCommand(edgeStacksDeleteCmd)
+	edgeStacksCmd.AddCommand(edgeStacksStatusCmd)
+}
+
+func lookupEdgeStack(ctx context.Context, cl *client.Client, ref string) (*types.EdgeStack, error) {
+	if id, err := strconv.Atoi(ref); err == nil {
+		stack, err := cl.GetEdgeStack(ctx, id)
+		if err != nil {
+			return nil, apiError(err, "get edge stack", "edge stack")
+		}
+		return stack, nil
+	}
+
+	stacks, err := cl.ListEdgeStacks(ctx)
+	if err != nil {
+		return nil, apiError(err, "list edge stacks", "edge stack")
+	}
+
+	for i := range stacks {
+		if stacks[i].Name == ref {
+			return &stacks[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("edge stack %q not found", ref)
+}
+
+func findEdgeGroup(groups []types.EdgeGroup, ref string) *types.EdgeGroup {
+	id, _ := strconv.Atoi(ref)
+	for i := range groups {
+		if groups[i].Name == ref || (id > 0 && groups[i].ID == id) {
+			return &groups[i]
+		}
+	}
+	return nil
+}
+
+func resolveEdgeGroupIDs(groups []types.EdgeGroup, refs []string) ([]int, error) {
+	ids := make([]int, 0, len(refs))
+	for _, ref := range refs {
+		group := findEdgeGroup(groups, ref)
+		if group == nil {
+			return nil, fmt.Errorf("edge group %q not found", ref)
+		}
+		ids = append(ids, group.ID)
+	}
+	return ids, nil
+}
+
+func edgeStackDetails(stacks []types.EdgeStack, groups []types.EdgeGroup) []types.EdgeStackDetails {
+	groupNames := make(map[int]string, len(groups))
+	for _, group := range groups {
+		groupNames[group.ID] = group.Name
+	}
+
+	details := make([]types.EdgeStackDetails, 0, len(stacks))
+	for _, stack := range stacks {
+		detail := types.EdgeStackDetails{EdgeStack: stack}
+		for _, groupID := range stack.EdgeGroups {
+			name, ok := groupNames[groupID]
+			if !ok {
+				name = strconv.Itoa(groupID)
+			}
+			detail.EdgeGroupNames = append(detail.EdgeGroupNames, name)
+		}
+		details = append(details, detail)
+	}
+
+	return details
+}
+
+func edgeStackEndpointStatuses(stack types.EdgeStack, groups []types.EdgeGroup, endpoints []types.Endpoint) []types.EdgeStackEndpointStatus {
+	endpointNames := make(map[int]string, len(endpoints))
+	for _, endpoint := range endpoints {
+		endpointNames[endpoint.ID] = endpoint.Name
+	}
+
+	// Environments of the targeted groups that did not report yet have no
+	// status, and environments that left a group still report one
+	targets := map[int]bool{}
+	for _, groupID := range stack.EdgeGroups {
+		for _, group := range groups {
+			if group.ID != groupID {
+				continue
+			}
+			for _, endpointID := range group.Endpoints {
+				targets[endpointID] = true
+			}
+		}
+	}
+	for endpointID := range stack.Status {
+		targets[endpointID] = true
+	}
+
+	statuses := make([]types.EdgeStackEndpointStatus, 0, len(targets))
+	for endpointID := range targets {
+		status := types.EdgeStackEndpointStatus{
+			EndpointID:   endpointID,
+			EndpointName: endpointNames[endpointID],
+			Status:       types.EdgeStackStatusPending.String(),
+		}
+		if current, ok := stack.Status[endpointID].Current(); ok {
+			status.Status = current.Type.String()
+			status.Error = current.Error
+			status.Time = current.Time
+		}
+		if status.EndpointName == "" {
+			status.EndpointName = strconv.Itoa(endpointID)
+		}
+		statuses = append(statuses, status)
+	}
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].EndpointName < statuses[j].EndpointName
+	})
+
+	return statuses
+}
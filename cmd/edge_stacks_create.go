@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var (
+	edgeStacksCreateName                    string
+	edgeStacksCreateEdgeGroups              []string
+	edgeStacksCreateDeploymentType          string
+	edgeStacksCreateFile                    string
+	edgeStacksCreateRepositoryURL           string
+	edgeStacksCreateRepositoryReferenceName string
+	edgeStacksCreateComposeFile             string
+	edgeStacksCreateRepositoryUsername      string
+	edgeStacksCreateRepositoryPassword      string
+	edgeStacksCreateTLSSkipVerify           bool
+)
+
+var edgeStacksCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create an edge stack",
+	Long: `Create an edge stack from a local file or from a Git repository and deploy it
+to the environments of one or more edge groups.
+
+Examples:
+  # Create from a compose file
+  portainer edge-stacks create --name telemetry --file docker-compose.yml --edge-group shops
+
+  # Create from stdin, targeting several groups
+  cat stack.yml | portainer edge-stacks create --name telemetry --file - --edge-group shops --edge-group warehouses
+
+  # Create from a Git repository
+  portainer edge-stacks create --name telemetry --repository-url https://github.com/acme/edge --compose-file telemetry/docker-compose.yml --edge-group shops
+
+  # Create a Kubernetes edge stack
+  portainer edge-stacks create --name telemetry --file manifest.yml --deployment-type kubernetes --edge-group k3s-sites`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if edgeStacksCreateName == "" {
+			return fmt.Errorf("--name is required")
+		}
+		if len(edgeStacksCreateEdgeGroups) == 0 {
+			return fmt.Errorf("at least one --edge-group is required")
+		}
+		if (edgeStacksCreateFile == "") == (edgeStacksCreateRepositoryURL == "") {
+			return fmt.Errorf("use exactly one of --file or --repository-url")
+		}
+		if (edgeStacksCreateRepositoryUsername == "") != (edgeStacksCreateRepositoryPassword == "") {
+			return fmt.Errorf("--repository-username and --repository-password must be provided together")
+		}
+
+		deploymentType, err := types.ParseEdgeStackDeploymentType(edgeStacksCreateDeploymentType)
+		if err != nil {
+			return err
+		}
+
+		cl, _, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		groups, err := cl.ListEdgeGroups(cmd.Context())
+		if err != nil {
+			return apiError(err, "list edge groups", "edge group")
+		}
+
+		groupIDs, err := resolveEdgeGroupIDs(groups, edgeStacksCreateEdgeGroups)
+		if err != nil {
+			return err
+		}
+
+		var stack *types.EdgeStack
+		if edgeStacksCreateFile != "" {
+			content, err := readObjectData(cmd, edgeStacksCreateFile, "")
+			if err != nil {
+				return err
+			}
+
+			stack, err = cl.CreateEdgeStackFromString(cmd.Context(), types.EdgeStackCreateFromStringRequest{
+				Name:             edgeStacksCreateName,
+				StackFileContent: string(content),
+				EdgeGroups:       groupIDs,
+				DeploymentType:   deploymentType,
+			})
+			if err != nil {
+				return apiError(err, "create edge stack", "edge group")
+			}
+		} else {
+			stack, err = cl.CreateEdgeStackFromGit(cmd.Context(), types.EdgeStackCreateFromGitRequest{
+				Name:                     edgeStacksCreateName,
+				RepositoryURL:            edgeStacksCreateRepositoryURL,
+				RepositoryReferenceName:  edgeStacksCreateRepositoryReferenceName,
+				RepositoryAuthentication: edgeStacksCreateRepositoryUsername != "",
+				RepositoryUsername:       edgeStacksCreateRepositoryUsername,
+				RepositoryPassword:       edgeStacksCreateRepositoryPassword,
+				FilePathInRepository:     edgeStacksCreateComposeFile,
+				EdgeGroups:               groupIDs,
+				DeploymentType:           deploymentType,
+				TLSSkipVerify:            edgeStacksCreateTLSSkipVerify,
+			})
+			if err != nil {
+				return apiError(err, "create edge stack", "edge group")
+			}
+		}
+
+		fmt.Printf("Edge stack '%s' created successfully (ID: %d)\n", stack.Name, stack.ID)
+		fmt.Printf("Follow the deployment with: portainer edge-stacks status %d\n", stack.ID)
+
+		return nil
+	},
+}
+
+func init() {
+	edgeStacksCreateCmd.Flags().StringVar(&edgeStacksCreateName, "name", "", "Name of the edge stack (required)")
+	edgeStacksCreateCmd.Flags().StringArrayVar(&edgeStacksCreateEdgeGroups, "edge-group", []string{}, "Edge group to deploy to, by name or ID (required, can be used multiple times)")
+	edgeStacksCreateCmd.Flags().StringVar(&edgeStacksCreateDeploymentType, "deployment-type", "compose", "Deployment type: compose or kubernetes")
+	edgeStacksCreateCmd.Flags().StringVar(&edgeStacksCreateFile, "file", "", "Stack file to deploy (- for stdin)")
+	edgeStacksCreateCmd.Flags().StringVar(&edgeStacksCreateRepositoryURL, "repository-url", "", "URL of the Git repository holding the stack file")
+	edgeStacksCreateCmd.Flags().StringVar(&edgeStacksCreateRepositoryReferenceName, "repository-reference-name", "", "Git reference (branch/tag)")
+	edgeStacksCreateCmd.Flags().StringVar(&edgeStacksCreateComposeFile, "compose-file", "docker-compose.yml", "Path to the stack file in the repository")
+	edgeStacksCreateCmd.Flags().StringVar(&edgeStacksCreateRepositoryUsername, "repository-username", "", "Username for Git repository authentication")
+	edgeStacksCreateCmd.Flags().StringVar(&edgeStacksCreateRepositoryPassword, "repository-password", "", "Password for Git repository authentication")
+	edgeStacksCreateCmd.Flags().BoolVar(&edgeStacksCreateTLSSkipVerify, "tlsskip-verify", false, "Skip TLS verification for Git repository")
+}
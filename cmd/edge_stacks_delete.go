@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var edgeStacksDeleteCmd = &cobra.Command{
+	Use:     "delete [edge-stack...]",
+	Aliases: []string{"rm"},
+	Short:   "Delete one or more edge stacks",
+	Long: `Delete one or more edge stacks referenced by ID or name. The stacks are removed
+from every edge environment.
+
+Examples:
+  # Delete an edge stack
+  portainer edge-stacks delete telemetry`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, _, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		failed := 0
+		for _, ref := range args {
+			stack, err := lookupEdgeStack(cmd.Context(), cl, ref)
+			if err != nil {
+				fmt.Printf("Error: %s: %v\n", ref, err)
+				failed++
+				continue
+			}
+
+			if err := cl.DeleteEdgeStack(cmd.Context(), stack.ID); err != nil {
+				fmt.Printf("Error: %s: %v\n", ref, apiError(err, "delete edge stack", "edge stack"))
+				failed++
+				continue
+			}
+			fmt.Printf("Deleted %s\n", stack.Name)
+		}
+
+		if failed > 0 {
+			return fmt.Errorf("failed to delete %d of %d edge stacks", failed, len(args))
+		}
+
+		return nil
+	},
+}
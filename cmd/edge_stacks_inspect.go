@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"github.com/pdrhp/portainer-go-cli/internal/printer"
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var edgeStacksInspectCmd = &cobra.Command{
+	Use:   "inspect [edge-stack]",
+	Short: "Show details of an edge stack",
+	Long: `Show details of an edge stack referenced by ID or name.
+
+Examples:
+  # Inspect an edge stack
+  portainer edge-stacks inspect telemetry
+
+  # Output in JSON format
+  portainer edge-stacks inspect 4 --output json`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, _, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		stack, err := lookupEdgeStack(cmd.Context(), cl, args[0])
+		if err != nil {
+			return err
+		}
+
+		groups, err := cl.ListEdgeGroups(cmd.Context())
+		if err != nil {
+			return apiError(err, "list edge groups", "edge group")
+		}
+
+		outputFormat := cmd.Flag("output").Value.String()
+
+		return printer.PrintEdgeStack(edgeStackDetails([]types.EdgeStack{*stack}, groups)[0], outputFormat)
+	},
+}
@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/internal/printer"
+	"github.com/spf13/cobra"
+)
+
+var edgeStacksListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List edge stacks",
+	Long: `List edge stacks with their edge groups and a summary of their deployment state.
+
+Examples:
+  # List edge stacks
+  portainer edge-stacks list
+
+  # Output in JSON format
+  portainer edge-stacks list --output json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, _, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		stacks, err := cl.ListEdgeStacks(cmd.Context())
+		if err != nil {
+			return apiError(err, "list edge stacks", "edge stack")
+		}
+
+		if len(stacks) == 0 {
+			fmt.Println("No edge stacks found.")
+			return nil
+		}
+
+		groups, err := cl.ListEdgeGroups(cmd.Context())
+		if err != nil {
+			return apiError(err, "list edge groups", "edge group")
+		}
+
+		outputFormat := cmd.Flag("output").Value.String()
+
+		return printer.PrintEdgeStacks(edgeStackDetails(stacks, groups), outputFormat)
+	},
+}
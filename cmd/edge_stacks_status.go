@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/internal/printer"
+	"github.com/spf13/cobra"
+)
+
+var edgeStacksStatusCmd = &cobra.Command{
+	Use:   "status [edge-stack]",
+	Short: "Show the deployment state of an edge stack per environment",
+	Long: `Show the deployment state of an edge stack on every environment of its edge
+groups, with a summary across all environments. Environments that did not
+report yet are shown as pending.
+
+Examples:
+  # Show the deployment state
+  portainer edge-stacks status telemetry
+
+  # Output in JSON format
+  portainer edge-stacks status 4 --output json`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, _, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		stack, err := lookupEdgeStack(cmd.Context(), cl, args[0])
+		if err != nil {
+			return err
+		}
+
+		groups, err := cl.ListEdgeGroups(cmd.Context())
+		if err != nil {
+			return apiError(err, "list edge groups", "edge group")
+		}
+
+		endpoints, err := cl.ListEndpoints(cmd.Context(), nil)
+		if err != nil {
+			return apiError(err, "list endpoints", "endpoint")
+		}
+
+		statuses := edgeStackEndpointStatuses(*stack, groups, endpoints)
+		if len(statuses) == 0 {
+			fmt.Printf("Edge stack '%s' does not target any environment.\n", stack.Name)
+			return nil
+		}
+
+		outputFormat := cmd.Flag("output").Value.String()
+
+		return printer.PrintEdgeStackStatus(statuses, outputFormat)
+	},
+}
@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEdgeStackEndpointStatuses(t *testing.T) {
+	stack := types.EdgeStack{
+		ID:         4,
+		Name:       "telemetry",
+		EdgeGroups: []int{1, 2},
+		Status: map[int]types.EdgeStackStatus{
+			10: {EndpointID: 10, Status: []types.EdgeStackDeploymentStatus{
+				{Type: types.EdgeStackStatusDeploymentReceived, Time: 100},
+				{Type: types.EdgeStackStatusRunning, Time: 200},
+			}},
+			11: {EndpointID: 11, Status: []types.EdgeStackDeploymentStatus{
+				{Type: types.EdgeStackStatusError, Error: "image not found", Time: 150},
+			}},
+			30: {EndpointID: 30, Status: []types.EdgeStackDeploymentStatus{
+				{Type: types.EdgeStackStatusRemoving},
+			}},
+		},
+	}
+	groups := []types.EdgeGroup{
+		{ID: 1, Name: "shops", Endpoints: []int{10, 11}},
+		{ID: 2, Name: "warehouses", Dynamic: true, Endpoints: []int{12}},
+		{ID: 3, Name: "labs", Endpoints: []int{20}},
+	}
+	endpoints := []types.Endpoint{
+		{ID: 10, Name: "shop-paris"},
+		{ID: 11, Name: "shop-lyon"},
+		{ID: 12, Name: "warehouse-lille"},
+		{ID: 20, Name: "lab"},
+	}
+
+	statuses := edgeStackEndpointStatuses(stack, groups, endpoints)
+
+	require.Len(t, statuses, 4)
+	assert.Equal(t, types.EdgeStackEndpointStatus{EndpointID: 30, EndpointName: "30", Status: "removing"}, statuses[0])
+	assert.Equal(t, types.EdgeStackEndpointStatus{EndpointID: 11, EndpointName: "shop-lyon", Status: "error", Error: "image not found", Time: 150}, statuses[1])
+	assert.Equal(t, types.EdgeStackEndpointStatus{EndpointID: 10, EndpointName: "shop-paris", Status: "running", Time: 200}, statuses[2])
+	assert.Equal(t, types.EdgeStackEndpointStatus{EndpointID: 12, EndpointName: "warehouse-lille", Status: "pending"}, statuses[3])
+}
+
+func TestEdgeGroupDetails(t *testing.T) {
+	details := edgeGroupDetails(
+		[]types.EdgeGroup{
+			{ID: 1, Name: "shops", Endpoints: []int{10, 11}},
+			{ID: 2, Name: "warehouses", Dynamic: true, TagIDs: []int{5}, Endpoints: []int{12}},
+		},
+		[]types.Tag{{ID: 5, Name: "warehouse"}},
+		[]types.Endpoint{{ID: 10, Name: "shop-paris"}, {ID: 11, Name: "shop-lyon"}, {ID: 12, Name: "warehouse-lille"}},
+	)
+
+	require.Len(t, details, 2)
+	assert.Equal(t, []string{"shop-paris", "shop-lyon"}, details[0].EndpointNames)
+	assert.Equal(t, "static", details[0].Kind())
+	assert.Equal(t, []string{"warehouse"}, details[1].TagNames)
+	assert.Equal(t, "dynamic", details[1].Kind())
+}
@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var (
+	edgeStacksUpdateFile       string
+	edgeStacksUpdateEdgeGroups []string
+	edgeStacksUpdateRedeploy   bool
+)
+
+var edgeStacksUpdateCmd = &cobra.Command{
+	Use:   "update [edge-stack]",
+	Short: "Update an edge stack",
+	Long: `Update the stack file or the edge groups of an edge stack referenced by ID or
+name. Options that are not given keep their current value.
+
+A new stack file is redeployed to every environment. Use --redeploy to
+redeploy the current file, for example to pull updated images.
+
+Examples:
+  # Deploy a new version of the stack file
+  portainer edge-stacks update telemetry --file docker-compose.yml
+
+  # Change the targeted edge groups
+  portainer edge-stacks update telemetry --edge-group shops --edge-group warehouses
+
+  # Redeploy without changes
+  portainer edge-stacks update telemetry --redeploy`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if edgeStacksUpdateFile == "" && len(edgeStacksUpdateEdgeGroups) == 0 && !edgeStacksUpdateRedeploy {
+			return fmt.Errorf("nothing to update. Use --file, --edge-group or --redeploy")
+		}
+
+		cl, _, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		stack, err := lookupEdgeStack(cmd.Context(), cl, args[0])
+		if err != nil {
+			return err
+		}
+
+		if edgeStacksUpdateFile != "" && stack.FromGit() {
+			return fmt.Errorf("edge stack '%s' is deployed from %s, push the change to the repository instead", stack.Name, stack.GitConfig.URL)
+		}
+
+		request := types.EdgeStackUpdateRequest{
+			EdgeGroups:     stack.EdgeGroups,
+			DeploymentType: stack.DeploymentType,
+			UpdateVersion:  edgeStacksUpdateFile != "" || edgeStacksUpdateRedeploy,
+		}
+
+		if edgeStacksUpdateFile != "" {
+			content, err := readObjectData(cmd, edgeStacksUpdateFile, "")
+			if err != nil {
+				return err
+			}
+			request.StackFileContent = string(content)
+		} else {
+			request.StackFileContent, err = cl.GetEdgeStackFile(cmd.Context(), stack.ID)
+			if err != nil {
+				return apiError(err, "get edge stack file", "edge stack")
+			}
+		}
+
+		if len(edgeStacksUpdateEdgeGroups) > 0 {
+			groups, err := cl.ListEdgeGroups(cmd.Context())
+			if err != nil {
+				return apiError(err, "list edge groups", "edge group")
+			}
+
+			request.EdgeGroups, err = resolveEdgeGroupIDs(groups, edgeStacksUpdateEdgeGroups)
+			if err != nil {
+				return err
+			}
+		}
+
+		updated, err := cl.UpdateEdgeStack(cmd.Context(), stack.ID, request)
+		if err != nil {
+			return apiError(err, "update edge stack", "edge stack")
+		}
+
+		fmt.Printf("Edge stack '%s' updated successfully (version %d)\n", updated.Name, updated.Version)
+		return nil
+	},
+}
+
+func init() {
+	edgeStacksUpdateCmd.Flags().StringVar(&edgeStacksUpdateFile, "file", "", "New stack file (- for stdin)")
+	edgeStacksUpdateCmd.Flags().StringArrayVar(&edgeStacksUpdateEdgeGroups, "edge-group", []string{}, "Edge group to deploy to, replaces the current groups (can be used multiple times)")
+	edgeStacksUpdateCmd.Flags().BoolVar(&edgeStacksUpdateRedeploy, "redeploy", false, "Redeploy the stack to every environment")
+}
@@ -0,0 +1,222 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/internal/printer"
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var (
+	endpointGroupsCreateDescription string
+	endpointGroupsCreateTags        []string
+)
+
+var endpointGroupsCmd = &cobra.Command{
+	Use:     "endpoint-groups",
+	Aliases: []string{"environment-groups"},
+	Short:   "Manage environment groups",
+	Long:    `Manage Portainer environment (endpoint) groups and their members`,
+}
+
+func init() {
+	endpointGroupsCreateCmd.Flags().StringVar(&endpointGroupsCreateDescription, "description", "", "Description of the group")
+	endpointGroupsCreateCmd.Flags().StringArrayVar(&endpointGroupsCreateTags, "tag", []string{}, "Tag of the group, inherited by its environments (can be used multiple times)")
+
+	endpointGroupsCmd.AddCommand(endpointGroupsListCmd)
+	endpointGroupsCmd.AddCommand(endpointGroupsCreateCmd)
+	endpointGroupsCmd.AddCommand(endpointGroupsAddEndpointCmd)
+	endpointGroupsCmd.AddCommand(endpointGroupsRemoveEndpointCmd)
+}
+
+var endpointGroupsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List environment groups",
+	Long: `List environment groups with their tags and environments.
+
+Examples:
+  # List groups
+  portainer endpoint-groups list
+
+  # Output in JSON format
+  portainer endpoint-groups list --output json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, _, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		groups, err := cl.ListEndpointGroups(cmd.Context())
+		if err != nil {
+			return apiError(err, "list endpoint groups", "endpoint group")
+		}
+
+		if len(groups) == 0 {
+			fmt.Println("No endpoint groups found.")
+			return nil
+		}
+
+		tags, err := cl.ListTags(cmd.Context())
+		if err != nil {
+			return apiError(err, "list tags", "tag")
+		}
+
+		endpoints, err := cl.ListEndpoints(cmd.Context(), nil)
+		if err != nil {
+			return apiError(err, "list endpoints", "endpoint")
+		}
+
+		outputFormat := cmd.Flag("output").Value.String()
+
+		return printer.PrintEndpointGroups(endpointGroupDetails(groups, tags, endpoints), outputFormat)
+	},
+}
+
+var endpointGroupsCreateCmd = &cobra.Command{
+	Use:   "create [name]",
+	Short: "Create an environment group",
+	Long: `Create an environment group.
+
+Examples:
+  # Create a group
+  portainer endpoint-groups create edge-sites --description "Shops and warehouses"
+
+  # Create a group whose environments inherit the prod tag
+  portainer endpoint-groups create datacenter --tag prod`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, _, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		groups, err := cl.ListEndpointGroups(cmd.Context())
+		if err != nil {
+			return apiError(err, "list endpoint groups", "endpoint group")
+		}
+		if group := findEndpointGroup(groups, args[0]); group != nil && group.Name == args[0] {
+			return fmt.Errorf("endpoint group %q already exists", args[0])
+		}
+
+		tagIDs := []int{}
+		if len(endpointGroupsCreateTags) > 0 {
+			tags, err := cl.ListTags(cmd.Context())
+			if err != nil {
+				return apiError(err, "list tags", "tag")
+			}
+			for _, ref := range endpointGroupsCreateTags {
+				tag := findTag(tags, ref)
+				if tag == nil {
+					return fmt.Errorf("tag %q not found", ref)
+				}
+				tagIDs = append(tagIDs, tag.ID)
+			}
+		}
+
+		group, err := cl.CreateEndpointGroup(cmd.Context(), types.EndpointGroupCreateRequest{
+			Name:        args[0],
+			Description: endpointGroupsCreateDescription,
+			TagIDs:      tagIDs,
+		})
+		if err != nil {
+			return apiError(err, "create endpoint group", "endpoint group")
+		}
+
+		fmt.Printf("Endpoint group '%s' created successfully (ID: %d)\n", group.Name, group.ID)
+		return nil
+	},
+}
+
+var endpointGroupsAddEndpointCmd = &cobra.Command{
+	Use:   "add-endpoint [group] [endpoint...]",
+	Short: "Move environments into a group",
+	Long: `Move environments into a group. An environment belongs to a single group, so
+it leaves its current group.
+
+Examples:
+  # Move environments into a group
+  portainer endpoint-groups add-endpoint edge-sites shop-paris shop-lyon`,
+	Args: cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return changeEndpointGroup(cmd, args[0], args[1:], true)
+	},
+}
+
+var endpointGroupsRemoveEndpointCmd = &cobra.Command{
+	Use:   "remove-endpoint [group] [endpoint...]",
+	Short: "Remove environments from a group",
+	Long: `Remove environments from a group. They are moved back to the Unassigned group.
+
+Examples:
+  # Remove an environment from a group
+  portainer endpoint-groups remove-endpoint edge-sites shop-lyon`,
+	Args: cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return changeEndpointGroup(cmd, args[0], args[1:], false)
+	},
+}
+
+func changeEndpointGroup(cmd *cobra.Command, groupRef string, endpointRefs []string, add bool) error {
+	cl, _, err := newAPIClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	groups, err := cl.ListEndpointGroups(cmd.Context())
+	if err != nil {
+		return apiError(err, "list endpoint groups", "endpoint group")
+	}
+
+	group := findEndpointGroup(groups, groupRef)
+	if group == nil {
+		return fmt.Errorf("endpoint group %q not found", groupRef)
+	}
+
+	for _, ref := range endpointRefs {
+		endpointID, err := lookupEndpointID(cmd.Context(), cl, ref)
+		if err != nil {
+			return err
+		}
+
+		if add {
+			if err := cl.AddEndpointToGroup(cmd.Context(), group.ID, endpointID); err != nil {
+				return apiError(err, "add "+ref+" to group", "endpoint group")
+			}
+			fmt.Printf("Added %s to group '%s'\n", ref, group.Name)
+			continue
+		}
+
+		if err := cl.RemoveEndpointFromGroup(cmd.Context(), group.ID, endpointID); err != nil {
+			return apiError(err, "remove "+ref+" from group", "endpoint group")
+		}
+		fmt.Printf("Removed %s from group '%s'\n", ref, group.Name)
+	}
+
+	return nil
+}
+
+func endpointGroupDetails(groups []types.EndpointGroup, tags []types.Tag, endpoints []types.Endpoint) []types.EndpointGroupDetails {
+	tagNames := make(map[int]string, len(tags))
+	for _, tag := range tags {
+		tagNames[tag.ID] = tag.Name
+	}
+
+	details := make([]types.EndpointGroupDetails, 0, len(groups))
+	for _, group := range groups {
+		detail := types.EndpointGroupDetails{EndpointGroup: group}
+		for _, tagID := range group.TagIDs {
+			if name, ok := tagNames[tagID]; ok {
+				detail.TagNames = append(detail.TagNames, name)
+			}
+		}
+		for _, endpoint := range endpoints {
+			if endpoint.GroupID == group.ID {
+				detail.Endpoints = append(detail.Endpoints, endpoint.Name)
+			}
+		}
+		details = append(details, detail)
+	}
+
+	return details
+}
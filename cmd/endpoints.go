@@ -0,0 +1,142 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/pdrhp/portainer-go-cli/internal/client"
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var endpointsCmd = &cobra.Command{
+	Use:     "endpoints",
+	Aliases: []string{"environments"},
+	Short:   "Manage environments (endpoints)",
+	Long:    `Discover Portainer environments (endpoints) and their Swarm cluster identifiers`,
+}
+
+func init() {
+	endpointsCmd.AddCommand(endpointsListCmd)
+	endpointsCmd.AddCommand(endpointsInspectCmd)
+}
+
+func buildEndpointDetails(ctx context.Context, cl *client.Client, endpoints []types.Endpoint) ([]types.EndpointDetails, error) {
+	groups, err := cl.ListEndpointGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	groupNames := make(map[int]string, len(groups))
+	for _, group := range groups {
+		groupNames[group.ID] = group.Name
+	}
+
+	tags, err := cl.ListTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tagNames := make(map[int]string, len(tags))
+	for _, tag := range tags {
+		tagNames[tag.ID] = tag.Name
+	}
+
+	details := make([]types.EndpointDetails, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		detail := types.EndpointDetails{
+			Endpoint:  endpoint,
+			GroupName: groupNames[endpoint.GroupID],
+		}
+
+		for _, tagID := range endpoint.TagIDs {
+			if name, ok := tagNames[tagID]; ok {
+				detail.TagNames = append(detail.TagNames, name)
+			}
+		}
+
+		// The Swarm cluster ID is only reachable through a live Docker proxy,
+		// so unreachable environments are listed without it.
+		if endpoint.IsSwarm() && endpoint.Status == types.EndpointStatusUp {
+			if swarmID, err := cl.GetSwarmID(ctx, endpoint.ID); err == nil {
+				detail.SwarmID = swarmID
+			}
+		}
+
+		details = append(details, detail)
+	}
+
+	return details, nil
+}
+
+func findTag(tags []types.Tag, ref string) *types.Tag {
+	id, _ := strconv.Atoi(ref)
+	for i := range tags {
+		if tags[i].Name == ref || (id > 0 && tags[i].ID == id) {
+			return &tags[i]
+		}
+	}
+	return nil
+}
+
+func findEndpointGroup(groups []types.EndpointGroup, ref string) *types.EndpointGroup {
+	id, _ := strconv.Atoi(ref)
+	for i := range groups {
+		if groups[i].Name == ref || (id > 0 && groups[i].ID == id) {
+			return &groups[i]
+		}
+	}
+	return nil
+}
+
+func filterEndpoints(ctx context.Context, cl *client.Client, endpoints []types.Endpoint, tagRef, groupRef string) ([]types.Endpoint, error) {
+	if tagRef == "" && groupRef == "" {
+		return endpoints, nil
+	}
+
+	groups, err := cl.ListEndpointGroups(ctx)
+	if err != nil {
+		return nil, apiError(err, "list endpoint groups", "endpoint group")
+	}
+
+	groupID := 0
+	if groupRef != "" {
+		group := findEndpointGroup(groups, groupRef)
+		if group == nil {
+			return nil, fmt.Errorf("endpoint group %q not found", groupRef)
+		}
+		groupID = group.ID
+	}
+
+	tagID := 0
+	groupTags := map[int][]int{}
+	if tagRef != "" {
+		tags, err := cl.ListTags(ctx)
+		if err != nil {
+			return nil, apiError(err, "list tags", "tag")
+		}
+		tag := findTag(tags, tagRef)
+		if tag == nil {
+			return nil, fmt.Errorf("tag %q not found", tagRef)
+		}
+		tagID = tag.ID
+
+		for _, group := range groups {
+			groupTags[group.ID] = group.TagIDs
+		}
+	}
+
+	var filtered []types.Endpoint
+	for _, endpoint := range endpoints {
+		if groupID > 0 && endpoint.GroupID != groupID {
+			continue
+		}
+		// Like in the Portainer UI, environments inherit the tags of their group
+		if tagID > 0 && !slices.Contains(endpoint.TagIDs, tagID) && !slices.Contains(groupTags[endpoint.GroupID], tagID) {
+			continue
+		}
+		filtered = append(filtered, endpoint)
+	}
+
+	return filtered, nil
+}
@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"github.com/pdrhp/portainer-go-cli/internal/printer"
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var endpointsInspectCmd = &cobra.Command{
+	Use:   "inspect [endpoint]",
+	Short: "Show details of an environment",
+	Long: `Show type, URL, status, group, tags and Swarm cluster ID of an environment.
+
+Examples:
+  # Inspect environment 1
+  portainer endpoints inspect 1
+
+  # Inspect environment by name
+  portainer endpoints inspect prod-swarm
+
+  # Output in YAML format
+  portainer endpoints inspect 1 --output yaml`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, _, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		endpointID, err := lookupEndpointID(cmd.Context(), cl, args[0])
+		if err != nil {
+			return err
+		}
+
+		endpoint, err := cl.GetEndpoint(cmd.Context(), endpointID)
+		if err != nil {
+			return apiError(err, "inspect endpoint", "endpoint")
+		}
+
+		details, err := buildEndpointDetails(cmd.Context(), cl, []types.Endpoint{*endpoint})
+		if err != nil {
+			return apiError(err, "inspect endpoint", "endpoint")
+		}
+
+		outputFormat := cmd.Flag("output").Value.String()
+
+		return printer.PrintEndpoint(details[0], outputFormat)
+	},
+}
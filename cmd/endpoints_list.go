@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/internal/printer"
+	"github.com/spf13/cobra"
+)
+
+var (
+	endpointsListTag   string
+	endpointsListGroup string
+)
+
+var endpointsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List environments",
+	Long: `List all environments (endpoints) accessible to the authenticated user.
+
+For Swarm environments the Swarm cluster ID is fetched from the Docker API,
+ready to be used with --swarm-id.
+
+Examples:
+  # List all environments
+  portainer endpoints list
+
+  # List environments tagged prod, directly or through their group
+  portainer endpoints list --tag prod
+
+  # List environments of a group
+  portainer endpoints list --group edge-sites
+
+  # Output in JSON format
+  portainer endpoints list --output json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, _, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		endpoints, err := cl.ListEndpoints(cmd.Context(), nil)
+		if err != nil {
+			return apiError(err, "list endpoints", "endpoint")
+		}
+
+		endpoints, err = filterEndpoints(cmd.Context(), cl, endpoints, endpointsListTag, endpointsListGroup)
+		if err != nil {
+			return err
+		}
+
+		if len(endpoints) == 0 {
+			fmt.Println("No endpoints found.")
+			return nil
+		}
+
+		details, err := buildEndpointDetails(cmd.Context(), cl, endpoints)
+		if err != nil {
+			return apiError(err, "list endpoints", "endpoint")
+		}
+
+		outputFormat := cmd.Flag("output").Value.String()
+
+		return printer.PrintEndpoints(details, outputFormat)
+	},
+}
+
+func init() {
+	endpointsListCmd.Flags().StringVar(&endpointsListTag, "tag", "", "Only list environments with this tag (name or ID)")
+	endpointsListCmd.Flags().StringVar(&endpointsListGroup, "group", "", "Only list environments of this group (name or ID)")
+}
@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pdrhp/portainer-go-cli/internal/client"
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterEndpoints_TagInheritedFromGroup(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/endpoint_groups":
+			w.Write([]byte(`[{"Id":1,"Name":"Unassigned","TagIds":[]},{"Id":2,"Name":"datacenter","TagIds":[7]}]`))
+		case "/api/tags":
+			w.Write([]byte(`[{"ID":7,"Name":"prod"},{"ID":8,"Name":"staging"}]`))
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	cl := client.New(server.URL)
+	cl.SetToken("test-token")
+
+	endpoints := []types.Endpoint{
+		{ID: 1, Name: "local", GroupID: 1},
+		{ID: 2, Name: "prod-swarm", GroupID: 2},
+		{ID: 3, Name: "edge", GroupID: 1, TagIDs: []int{7}},
+		{ID: 4, Name: "staging-swarm", GroupID: 1, TagIDs: []int{8}},
+	}
+
+	filtered, err := filterEndpoints(context.Background(), cl, endpoints, "prod", "")
+	require.NoError(t, err)
+	require.Len(t, filtered, 2)
+	assert.Equal(t, "prod-swarm", filtered[0].Name)
+	assert.Equal(t, "edge", filtered[1].Name)
+
+	filtered, err = filterEndpoints(context.Background(), cl, endpoints, "prod", "Unassigned")
+	require.NoError(t, err)
+	require.Len(t, filtered, 1)
+	assert.Equal(t, "edge", filtered[0].Name)
+
+	_, err = filterEndpoints(context.Background(), cl, endpoints, "", "missing")
+	assert.ErrorContains(t, err, "not found")
+}
+
+func TestEndpointGroupDetails(t *testing.T) {
+	details := endpointGroupDetails(
+		[]types.EndpointGroup{{ID: 2, Name: "datacenter", TagIDs: []int{7}}},
+		[]types.Tag{{ID: 7, Name: "prod"}},
+		[]types.Endpoint{{ID: 1, Name: "local", GroupID: 1}, {ID: 2, Name: "prod-swarm", GroupID: 2}},
+	)
+
+	require.Len(t, details, 1)
+	assert.Equal(t, []string{"prod"}, details[0].TagNames)
+	assert.Equal(t, []string{"prod-swarm"}, details[0].Endpoints)
+}
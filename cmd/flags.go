@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+)
+
+func parseKeyValueFlags(values []string, flag string) (map[string]string, error) {
+	result, invalid := splitKeyValues(values)
+	if invalid >= 0 {
+		return nil, fmt.Errorf("invalid --%s value %q, expected KEY=value", flag, values[invalid])
+	}
+
+	return result, nil
+}
+
+func parseKeyValueArgs(args []string) (map[string]string, error) {
+	result, invalid := splitKeyValues(args)
+	if invalid >= 0 {
+		return nil, fmt.Errorf("invalid argument %q, expected KEY=value", args[invalid])
+	}
+
+	return result, nil
+}
+
+// splitKeyValues returns the index of the first value without a key, or -1
+func splitKeyValues(values []string) (map[string]string, int) {
+	if len(values) == 0 {
+		return nil, -1
+	}
+
+	result := make(map[string]string, len(values))
+	for i, value := range values {
+		key, val, _ := strings.Cut(value, "=")
+		if strings.TrimSpace(key) == "" {
+			return nil, i
+		}
+		result[key] = val
+	}
+
+	return result, -1
+}
@@ -0,0 +1,30 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseKeyValueFlags(t *testing.T) {
+	values, err := parseKeyValueFlags([]string{"team=payments", "o=addr=10.0.0.5,rw", "empty="}, "opt")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"team": "payments", "o": "addr=10.0.0.5,rw", "empty": ""}, values)
+
+	values, err = parseKeyValueFlags(nil, "label")
+	require.NoError(t, err)
+	assert.Nil(t, values)
+
+	_, err = parseKeyValueFlags([]string{"=value"}, "label")
+	assert.EqualError(t, err, `invalid --label value "=value", expected KEY=value`)
+}
+
+func TestParseKeyValueArgs(t *testing.T) {
+	values, err := parseKeyValueArgs([]string{"zone=eu-west-1a", "storage=ssd"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"zone": "eu-west-1a", "storage": "ssd"}, values)
+
+	_, err = parseKeyValueArgs([]string{"zone=eu-west-1a", "=ssd"})
+	assert.EqualError(t, err, `invalid argument "=ssd", expected KEY=value`)
+}
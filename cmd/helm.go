@@ -0,0 +1,316 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pdrhp/portainer-go-cli/internal/client"
+	"github.com/pdrhp/portainer-go-cli/internal/printer"
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+var helmSearchRepo string
+
+var helmCmd = &cobra.Command{
+	Use:   "helm",
+	Short: "Manage Helm charts and releases",
+	Long:  `Manage Helm repositories, and install, upgrade and uninstall Helm releases on Kubernetes environments through Portainer`,
+}
+
+var helmRepoCmd = &cobra.Command{
+	Use:   "repo",
+	Short: "Manage Helm repositories",
+	Long:  `List and add the Helm repositories available to the current user`,
+}
+
+func init() {
+	helmCmd.AddCommand(helmRepoCmd)
+	helmCmd.AddCommand(helmSearchCmd)
+	helmCmd.AddCommand(helmListCmd)
+	helmCmd.AddCommand(helmInstallCmd)
+	helmCmd.AddCommand(helmUpgradeCmd)
+	helmCmd.AddCommand(helmUninstallCmd)
+
+	helmRepoCmd.AddCommand(helmRepoListCmd)
+	helmRepoCmd.AddCommand(helmRepoAddCmd)
+
+	helmSearchCmd.Flags().StringVar(&helmSearchRepo, "repo", "", "Repository URL to search (defaults to the global repository)")
+}
+
+func resolveHelmRepository(ctx context.Context, cl *client.Client, repo string) (string, error) {
+	if repo != "" {
+		return strings.TrimSuffix(repo, "/"), nil
+	}
+
+	user, err := cl.GetCurrentUser(ctx)
+	if err != nil {
+		return "", apiError(err, "get current user", "user")
+	}
+
+	repositories, err := cl.ListHelmRepositories(ctx, user.ID)
+	if err != nil {
+		return "", apiError(err, "list helm repositories", "user")
+	}
+	if repositories.GlobalRepository == "" {
+		return "", fmt.Errorf("no global helm repository configured. Use --repo")
+	}
+
+	return repositories.GlobalRepository, nil
+}
+
+func searchHelmCharts(index *types.HelmChartIndex, keyword string) []types.HelmChartVersion {
+	keyword = strings.ToLower(keyword)
+
+	var charts []types.HelmChartVersion
+	for name, versions := range index.Entries {
+		if len(versions) == 0 {
+			continue
+		}
+		// The repository index lists the versions of a chart newest first
+		latest := versions[0]
+		if latest.Name == "" {
+			latest.Name = name
+		}
+		if keyword != "" && !strings.Contains(strings.ToLower(latest.Name), keyword) &&
+			!strings.Contains(strings.ToLower(latest.Description), keyword) {
+			continue
+		}
+		charts = append(charts, latest)
+	}
+
+	sort.Slice(charts, func(i, j int) bool {
+		return charts[i].Name < charts[j].Name
+	})
+
+	return charts
+}
+
+func buildHelmValues(files []string, sets []string) (string, error) {
+	values := map[string]interface{}{}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return "", fmt.Errorf("failed to read values file: %w", err)
+		}
+
+		var fileValues map[string]interface{}
+		if err := yaml.Unmarshal(data, &fileValues); err != nil {
+			return "", fmt.Errorf("failed to parse values file %s: %w", file, err)
+		}
+		mergeHelmValues(values, fileValues)
+	}
+
+	for _, set := range sets {
+		key, raw, found := strings.Cut(set, "=")
+		if !found || strings.TrimSpace(key) == "" {
+			return "", fmt.Errorf("invalid --set value %q, expected key.path=value", set)
+		}
+		if err := setHelmValue(values, strings.Split(key, "."), raw); err != nil {
+			return "", fmt.Errorf("invalid --set value %q: %w", set, err)
+		}
+	}
+
+	if len(values) == 0 {
+		return "", nil
+	}
+
+	data, err := yaml.Marshal(values)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode values: %w", err)
+	}
+
+	return string(data), nil
+}
+
+func mergeHelmValues(dst map[string]interface{}, src map[string]interface{}) {
+	for key, value := range src {
+		srcMap, srcIsMap := value.(map[string]interface{})
+		dstMap, dstIsMap := dst[key].(map[string]interface{})
+		if srcIsMap && dstIsMap {
+			mergeHelmValues(dstMap, srcMap)
+			continue
+		}
+		dst[key] = value
+	}
+}
+
+func setHelmValue(values map[string]interface{}, path []string, raw string) error {
+	for _, key := range path[:len(path)-1] {
+		if key == "" {
+			return fmt.Errorf("empty key")
+		}
+		next, ok := values[key].(map[string]interface{})
+		if !ok {
+			next = map[string]interface{}{}
+			values[key] = next
+		}
+		values = next
+	}
+
+	last := path[len(path)-1]
+	if last == "" {
+		return fmt.Errorf("empty key")
+	}
+
+	values[last] = typedHelmValue(raw)
+
+	return nil
+}
+
+func typedHelmValue(raw string) interface{} {
+	// Same rules as helm --set: only booleans, null and plain integers are typed,
+	// so image tags like 1.10 or secrets starting with # stay strings
+	switch strings.ToLower(raw) {
+	case "true":
+		return true
+	case "false":
+		return false
+	case "null":
+		return nil
+	}
+
+	if raw == "0" {
+		return int64(0)
+	}
+	if !strings.HasPrefix(raw, "0") {
+		if number, err := strconv.ParseInt(raw, 10, 64); err == nil {
+			return number
+		}
+	}
+
+	return raw
+}
+
+var helmRepoListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List Helm repositories",
+	Long: `List the global Helm repository and the repositories added by the current user.
+
+Examples:
+  # List repositories
+  portainer helm repo list`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, _, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		user, err := cl.GetCurrentUser(cmd.Context())
+		if err != nil {
+			return apiError(err, "get current user", "user")
+		}
+
+		list, err := cl.ListHelmRepositories(cmd.Context(), user.ID)
+		if err != nil {
+			return apiError(err, "list helm repositories", "user")
+		}
+
+		repositories := list.Repositories()
+		if len(repositories) == 0 {
+			fmt.Println("No helm repositories found.")
+			return nil
+		}
+
+		outputFormat := cmd.Flag("output").Value.String()
+
+		return printer.PrintHelmRepositories(repositories, outputFormat)
+	},
+}
+
+var helmRepoAddCmd = &cobra.Command{
+	Use:   "add [url]",
+	Short: "Add a Helm repository",
+	Long: `Add a Helm repository for the current user. Existing repositories are left untouched.
+
+Examples:
+  # Add a repository
+  portainer helm repo add https://charts.bitnami.com/bitnami`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, _, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		repositoryURL := strings.TrimSuffix(args[0], "/")
+
+		user, err := cl.GetCurrentUser(cmd.Context())
+		if err != nil {
+			return apiError(err, "get current user", "user")
+		}
+
+		list, err := cl.ListHelmRepositories(cmd.Context(), user.ID)
+		if err != nil {
+			return apiError(err, "list helm repositories", "user")
+		}
+
+		for _, repository := range list.Repositories() {
+			if strings.EqualFold(repository.URL, repositoryURL) {
+				fmt.Printf("Helm repository '%s' already exists\n", repositoryURL)
+				return nil
+			}
+		}
+
+		repository, err := cl.AddHelmRepository(cmd.Context(), user.ID, repositoryURL)
+		if err != nil {
+			return apiError(err, "add helm repository", "user")
+		}
+
+		fmt.Printf("Helm repository '%s' added (ID: %d)\n", repository.URL, repository.ID)
+		return nil
+	},
+}
+
+var helmSearchCmd = &cobra.Command{
+	Use:   "search [keyword]",
+	Short: "Search charts of a Helm repository",
+	Long: `Search the charts of a Helm repository by name or description and show their latest version.
+
+Examples:
+  # List every chart of the global repository
+  portainer helm search
+
+  # Search a repository
+  portainer helm search postgres --repo https://charts.bitnami.com/bitnami
+
+  # Output in JSON format
+  portainer helm search redis --output json`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, _, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		repositoryURL, err := resolveHelmRepository(cmd.Context(), cl, helmSearchRepo)
+		if err != nil {
+			return err
+		}
+
+		index, err := cl.SearchHelmRepository(cmd.Context(), repositoryURL)
+		if err != nil {
+			return apiError(err, "search helm repository", "helm repository")
+		}
+
+		keyword := ""
+		if len(args) == 1 {
+			keyword = args[0]
+		}
+
+		charts := searchHelmCharts(index, keyword)
+		if len(charts) == 0 {
+			fmt.Println("No charts found.")
+			return nil
+		}
+
+		outputFormat := cmd.Flag("output").Value.String()
+
+		return printer.PrintHelmCharts(charts, outputFormat)
+	},
+}
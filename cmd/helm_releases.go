@@ -0,0 +1,207 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/internal/client"
+	"github.com/pdrhp/portainer-go-cli/internal/printer"
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var (
+	helmListNamespace      string
+	helmListFilter         string
+	helmInstallRepo        string
+	helmInstallNamespace   string
+	helmInstallVersion     string
+	helmInstallValues      []string
+	helmInstallSet         []string
+	helmUninstallNamespace string
+)
+
+func init() {
+	helmListCmd.Flags().StringVarP(&helmListNamespace, "namespace", "n", "", "Only list releases of this namespace (default: all namespaces)")
+	helmListCmd.Flags().StringVar(&helmListFilter, "filter", "", "Only list releases whose name matches this regular expression")
+
+	// install and upgrade share their flags, only one of them runs per invocation
+	for _, c := range []*cobra.Command{helmInstallCmd, helmUpgradeCmd} {
+		c.Flags().StringVar(&helmInstallRepo, "repo", "", "Repository URL of the chart (defaults to the global repository)")
+		c.Flags().StringVarP(&helmInstallNamespace, "namespace", "n", "default", "Namespace of the release")
+		c.Flags().StringVar(&helmInstallVersion, "version", "", "Chart version (default: latest)")
+		c.Flags().StringArrayVarP(&helmInstallValues, "values", "f", nil, "Values file (can be used multiple times, later files take precedence)")
+		c.Flags().StringArrayVar(&helmInstallSet, "set", nil, "Value in key.path=value format (can be used multiple times, overrides values files)")
+	}
+
+	helmUninstallCmd.Flags().StringVarP(&helmUninstallNamespace, "namespace", "n", "default", "Namespace of the releases")
+}
+
+func findHelmRelease(ctx context.Context, cl *client.Client, endpointID int, namespace string, name string) (*types.HelmRelease, error) {
+	releases, err := cl.ListHelmReleases(ctx, endpointID, namespace, "")
+	if err != nil {
+		return nil, apiError(err, "list helm releases", "endpoint")
+	}
+
+	for i := range releases {
+		if releases[i].Name == name {
+			return &releases[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+func runHelmInstall(cmd *cobra.Command, args []string, upgrade bool) error {
+	values, err := buildHelmValues(helmInstallValues, helmInstallSet)
+	if err != nil {
+		return err
+	}
+
+	cl, endpointID, err := newKubernetesClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	name, chart := args[0], args[1]
+
+	existing, err := findHelmRelease(cmd.Context(), cl, endpointID, helmInstallNamespace, name)
+	if err != nil {
+		return err
+	}
+	if upgrade && existing == nil {
+		return fmt.Errorf("release '%s' not found in namespace '%s'. Use 'portainer helm install' to create it", name, helmInstallNamespace)
+	}
+	if !upgrade && existing != nil {
+		return fmt.Errorf("release '%s' already exists in namespace '%s'. Use 'portainer helm upgrade' to change it", name, helmInstallNamespace)
+	}
+
+	repositoryURL, err := resolveHelmRepository(cmd.Context(), cl, helmInstallRepo)
+	if err != nil {
+		return err
+	}
+
+	release, err := cl.InstallHelmChart(cmd.Context(), endpointID, types.HelmInstallRequest{
+		Name:      name,
+		Namespace: helmInstallNamespace,
+		Chart:     chart,
+		Repo:      repositoryURL,
+		Version:   helmInstallVersion,
+		Values:    values,
+	})
+	if err != nil {
+		return apiError(err, "install helm chart", "helm chart")
+	}
+
+	outputFormat := cmd.Flag("output").Value.String()
+
+	return printer.PrintHelmReleaseDetails(*release, outputFormat)
+}
+
+var helmListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List Helm releases",
+	Long: `List the Helm releases of a Kubernetes environment.
+
+Examples:
+  # List releases of all namespaces
+  portainer helm list --endpoint prod-k8s
+
+  # List releases of a namespace
+  portainer helm list --namespace payments
+
+  # Output in JSON format
+  portainer helm list --output json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, endpointID, err := newKubernetesClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		releases, err := cl.ListHelmReleases(cmd.Context(), endpointID, helmListNamespace, helmListFilter)
+		if err != nil {
+			return apiError(err, "list helm releases", "endpoint")
+		}
+
+		if len(releases) == 0 {
+			fmt.Println("No helm releases found.")
+			return nil
+		}
+
+		outputFormat := cmd.Flag("output").Value.String()
+
+		return printer.PrintHelmReleases(releases, outputFormat)
+	},
+}
+
+var helmInstallCmd = &cobra.Command{
+	Use:   "install [release] [chart]",
+	Short: "Install a Helm chart",
+	Long: `Install a chart as a new Helm release on a Kubernetes environment.
+
+Examples:
+  # Install a chart of the global repository
+  portainer helm install cache redis --endpoint prod-k8s --namespace payments
+
+  # Install a pinned version from another repository with values
+  portainer helm install db postgresql \
+    --repo https://charts.bitnami.com/bitnami \
+    --version 15.5.0 \
+    --values values.yaml \
+    --set primary.persistence.size=20Gi`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runHelmInstall(cmd, args, false)
+	},
+}
+
+var helmUpgradeCmd = &cobra.Command{
+	Use:   "upgrade [release] [chart]",
+	Short: "Upgrade a Helm release",
+	Long: `Upgrade an existing Helm release to another chart version or values.
+
+Examples:
+  # Upgrade to the latest chart version
+  portainer helm upgrade cache redis --namespace payments
+
+  # Change values
+  portainer helm upgrade cache redis --namespace payments -f values.yaml --set replica.replicaCount=3`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runHelmInstall(cmd, args, true)
+	},
+}
+
+var helmUninstallCmd = &cobra.Command{
+	Use:     "uninstall [release...]",
+	Aliases: []string{"rm", "delete"},
+	Short:   "Uninstall one or more Helm releases",
+	Long: `Uninstall one or more Helm releases of a namespace.
+
+Examples:
+  # Uninstall releases
+  portainer helm uninstall cache db --namespace payments`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, endpointID, err := newKubernetesClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		failed := 0
+		for _, name := range args {
+			if err := cl.UninstallHelmRelease(cmd.Context(), endpointID, helmUninstallNamespace, name); err != nil {
+				fmt.Printf("Error: %s: %v\n", name, apiError(err, "uninstall helm release", "helm release"))
+				failed++
+				continue
+			}
+			fmt.Printf("Uninstalled %s\n", name)
+		}
+
+		if failed > 0 {
+			return fmt.Errorf("failed to uninstall %d of %d releases", failed, len(args))
+		}
+
+		return nil
+	},
+}
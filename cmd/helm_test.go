@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestBuildHelmValues(t *testing.T) {
+	dir := t.TempDir()
+	base := filepath.Join(dir, "base.yaml")
+	prod := filepath.Join(dir, "prod.yaml")
+	require.NoError(t, os.WriteFile(base, []byte("image:\n  tag: \"1.0\"\n  pullPolicy: IfNotPresent\nreplicas: 1\n"), 0o600))
+	require.NoError(t, os.WriteFile(prod, []byte("image:\n  tag: \"1.1\"\n"), 0o600))
+
+	raw, err := buildHelmValues([]string{base, prod}, []string{
+		"replicas=3",
+		"ingress.enabled=true",
+		"ingress.host=shop.example.com",
+		"sidecar.tag=1.10",
+		"password=#s3cret",
+		"date=2024-01-01",
+		"mode=0755",
+		"key=",
+		"annotations=null",
+	})
+	require.NoError(t, err)
+
+	var values map[string]interface{}
+	require.NoError(t, yaml.Unmarshal([]byte(raw), &values))
+	assert.Equal(t, map[string]interface{}{
+		"image":       map[string]interface{}{"tag": "1.1", "pullPolicy": "IfNotPresent"},
+		"replicas":    3,
+		"ingress":     map[string]interface{}{"enabled": true, "host": "shop.example.com"},
+		"sidecar":     map[string]interface{}{"tag": "1.10"},
+		"password":    "#s3cret",
+		"date":        "2024-01-01",
+		"mode":        "0755",
+		"key":         "",
+		"annotations": nil,
+	}, values)
+}
+
+func TestBuildHelmValues_Empty(t *testing.T) {
+	raw, err := buildHelmValues(nil, nil)
+
+	require.NoError(t, err)
+	assert.Empty(t, raw)
+}
+
+func TestBuildHelmValues_InvalidSet(t *testing.T) {
+	for _, set := range []string{"replicas", "=3", "image..tag=1"} {
+		_, err := buildHelmValues(nil, []string{set})
+		assert.Error(t, err, set)
+	}
+}
+
+func TestSearchHelmCharts(t *testing.T) {
+	index := &types.HelmChartIndex{Entries: map[string][]types.HelmChartVersion{
+		"redis":      {{Name: "redis", Version: "18.0.0", Description: "In-memory data store"}, {Name: "redis", Version: "17.0.0"}},
+		"postgresql": {{Name: "postgresql", Version: "15.5.0", Description: "Object-relational database"}},
+		"valkey":     {{Name: "valkey", Version: "1.0.0", Description: "Redis compatible data store"}},
+	}}
+
+	charts := searchHelmCharts(index, "redis")
+
+	require.Len(t, charts, 2)
+	assert.Equal(t, "redis", charts[0].Name)
+	assert.Equal(t, "18.0.0", charts[0].Version)
+	assert.Equal(t, "valkey", charts[1].Name)
+	assert.Len(t, searchHelmCharts(index, ""), 3)
+}
@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/pdrhp/portainer-go-cli/internal/client"
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var imagesNode string
+
+var imagesCmd = &cobra.Command{
+	Use:   "images",
+	Short: "Manage images",
+	Long:  `Manage Docker images of an environment through the Portainer Docker proxy`,
+}
+
+func init() {
+	imagesCmd.PersistentFlags().StringVar(&imagesNode, "node", "", "Target a specific swarm node (agent environments only)")
+
+	imagesCmd.AddCommand(imagesListCmd)
+	imagesCmd.AddCommand(imagesPullCmd)
+	imagesCmd.AddCommand(imagesRmCmd)
+	imagesCmd.AddCommand(imagesPruneCmd)
+}
+
+// imageNodes returns the nodes an image command runs on: the one given with
+// --node, or every node of an agent swarm since images are stored per node
+func imageNodes(ctx context.Context, cl *client.Client, endpointID int) ([]string, error) {
+	if imagesNode != "" {
+		return []string{imagesNode}, nil
+	}
+	return agentNodeNames(ctx, cl, endpointID)
+}
+
+func nodeTarget(cl *client.Client, node string) *client.Client {
+	if node == "" {
+		return cl
+	}
+	return cl.ForNode(node)
+}
+
+func listImageDetails(ctx context.Context, cl *client.Client, endpointID int) ([]types.ImageDetails, error) {
+	images, err := cl.ListImages(ctx, endpointID)
+	if err != nil {
+		return nil, err
+	}
+
+	containers, err := cl.ListContainers(ctx, endpointID, &types.ContainerFilters{All: true})
+	if err != nil {
+		return nil, err
+	}
+
+	usedBy := map[string][]string{}
+	for _, container := range containers {
+		usedBy[container.ImageID] = append(usedBy[container.ImageID], container.Name())
+	}
+
+	details := make([]types.ImageDetails, 0, len(images))
+	for _, image := range images {
+		details = append(details, types.ImageDetails{
+			Image:  image,
+			UsedBy: usedBy[image.ID],
+		})
+	}
+
+	return details, nil
+}
@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/internal/printer"
+	"github.com/spf13/cobra"
+)
+
+var imagesListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List images",
+	Long: `List images of an environment with their size and the containers using them.
+
+Examples:
+  # List images of the default endpoint
+  portainer images list
+
+  # List images of a specific swarm node
+  portainer images list --endpoint prod-swarm --node worker-2
+
+  # Output in JSON format
+  portainer images list --output json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, endpointID, err := newNodeClient(cmd, imagesNode)
+		if err != nil {
+			return err
+		}
+
+		images, err := listImageDetails(cmd.Context(), cl, endpointID)
+		if err != nil {
+			return apiError(err, "list images", "endpoint")
+		}
+		for i := range images {
+			images[i].Node = imagesNode
+		}
+
+		if len(images) == 0 {
+			fmt.Println("No images found.")
+			return nil
+		}
+
+		outputFormat := cmd.Flag("output").Value.String()
+
+		return printer.PrintImages(images, outputFormat)
+	},
+}
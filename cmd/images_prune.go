@@ -0,0 +1,137 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/internal/printer"
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var (
+	imagesPruneDangling bool
+	imagesPruneAll      bool
+	imagesPruneDryRun   bool
+)
+
+var imagesPruneCmd = &cobra.Command{
+	Use:   "prune",
+	Short: "Remove unused images",
+	Long: `Remove dangling images, or every image not used by a container with --all.
+
+Images are stored per node, so on agent-based swarm environments every ready
+node is pruned in turn unless --node selects one.
+
+Examples:
+  # Preview which dangling images would be removed
+  portainer images prune --dry-run
+
+  # Remove dangling images on a specific swarm node
+  portainer images prune --endpoint prod-swarm --node worker-2
+
+  # Remove all images not used by any container
+  portainer images prune --all`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if imagesPruneAll && cmd.Flags().Changed("dangling") && imagesPruneDangling {
+			return fmt.Errorf("--dangling and --all cannot be used together")
+		}
+
+		cl, endpointID, err := newNodeClient(cmd, imagesNode)
+		if err != nil {
+			return err
+		}
+
+		nodes, err := imageNodes(cmd.Context(), cl, endpointID)
+		if err != nil {
+			return err
+		}
+
+		if imagesPruneDryRun {
+			candidates := []types.ImageDetails{}
+			for _, node := range nodes {
+				images, err := listImageDetails(cmd.Context(), nodeTarget(cl, node), endpointID)
+				if err != nil {
+					return apiError(err, "list images", "endpoint")
+				}
+				for _, image := range imagePruneCandidates(images, imagesPruneAll) {
+					image.Node = node
+					candidates = append(candidates, image)
+				}
+			}
+
+			if len(candidates) == 0 {
+				fmt.Println("No images would be removed.")
+				return nil
+			}
+
+			outputFormat := cmd.Flag("output").Value.String()
+			if err := printer.PrintImages(candidates, outputFormat); err != nil {
+				return err
+			}
+
+			if outputFormat != "json" && outputFormat != "yaml" {
+				var total int64
+				for _, image := range candidates {
+					total += image.Size
+				}
+				fmt.Printf("\nWould remove %d images (%s)\n", len(candidates), printer.FormatSize(total))
+			}
+			return nil
+		}
+
+		failed := 0
+		var reclaimed int64
+		for _, node := range nodes {
+			if node != "" {
+				fmt.Printf("Node %s:\n", node)
+			}
+
+			report, err := nodeTarget(cl, node).PruneImages(cmd.Context(), endpointID, imagesPruneAll)
+			if err != nil && node == "" {
+				return apiError(err, "prune images", "endpoint")
+			}
+			if err != nil {
+				fmt.Printf("Error: %s: %v\n", node, apiError(err, "prune images", "endpoint"))
+				failed++
+				continue
+			}
+
+			for _, item := range report.ImagesDeleted {
+				if item.Untagged != "" {
+					fmt.Printf("Untagged: %s\n", item.Untagged)
+				}
+				if item.Deleted != "" {
+					fmt.Printf("Deleted: %s\n", item.Deleted)
+				}
+			}
+			reclaimed += report.SpaceReclaimed
+		}
+		fmt.Printf("Total reclaimed space: %s\n", printer.FormatSize(reclaimed))
+
+		if failed > 0 {
+			return fmt.Errorf("failed to prune images on %d of %d nodes", failed, len(nodes))
+		}
+
+		return nil
+	},
+}
+
+func imagePruneCandidates(images []types.ImageDetails, all bool) []types.ImageDetails {
+	candidates := []types.ImageDetails{}
+	for _, image := range images {
+		if len(image.UsedBy) > 0 {
+			continue
+		}
+		if all || image.IsDangling() {
+			candidates = append(candidates, image)
+		}
+	}
+
+	return candidates
+}
+
+func init() {
+	imagesPruneCmd.Flags().BoolVar(&imagesPruneDangling, "dangling", true, "Remove only dangling images (default)")
+	imagesPruneCmd.Flags().BoolVarP(&imagesPruneAll, "all", "a", false, "Remove all images not used by a container")
+	imagesPruneCmd.Flags().BoolVar(&imagesPruneDryRun, "dry-run", false, "Show the images that would be removed without removing them")
+}
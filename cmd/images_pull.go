@@ -0,0 +1,140 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pdrhp/portainer-go-cli/internal/client"
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var imagesPullRegistry string
+
+var imagesPullCmd = &cobra.Command{
+	Use:   "pull [image]",
+	Short: "Pull an image",
+	Long: `Pull an image on an environment, streaming Docker's progress.
+
+Credentials for private registries are taken from the registries configured in
+Portainer: the registry is matched on the image host, or selected with --registry.
+
+Images are stored per node, so on agent-based swarm environments the image is
+pulled on every ready node in turn unless --node selects one.
+
+Examples:
+  # Pull a public image
+  portainer images pull nginx:1.27
+
+  # Pull from a private registry configured in Portainer
+  portainer images pull registry.example.com/team/api:1.4.2
+
+  # Select the Portainer registry explicitly
+  portainer images pull team/api:1.4.2 --registry dockerhub-team`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, endpointID, err := newNodeClient(cmd, imagesNode)
+		if err != nil {
+			return err
+		}
+
+		registry, err := findPullRegistry(cmd.Context(), cl, args[0], imagesPullRegistry)
+		if err != nil {
+			return err
+		}
+
+		nodes, err := imageNodes(cmd.Context(), cl, endpointID)
+		if err != nil {
+			return err
+		}
+
+		registryID := 0
+		if registry != nil {
+			registryID = registry.ID
+			fmt.Printf("Pulling %s using registry '%s'...\n", args[0], registry.Name)
+		} else {
+			fmt.Printf("Pulling %s...\n", args[0])
+		}
+
+		failed := 0
+		for _, node := range nodes {
+			if node != "" {
+				fmt.Printf("Node %s:\n", node)
+			}
+
+			err = nodeTarget(cl, node).PullImage(cmd.Context(), endpointID, args[0], registryID, printPullProgress)
+			if err != nil && node == "" {
+				return apiError(err, "pull image", "image")
+			}
+			if err != nil {
+				fmt.Printf("Error: %s: %v\n", node, apiError(err, "pull image", "image"))
+				failed++
+			}
+		}
+
+		if failed > 0 {
+			return fmt.Errorf("failed to pull %s on %d of %d nodes", args[0], failed, len(nodes))
+		}
+
+		fmt.Printf("Image %s pulled successfully\n", args[0])
+		return nil
+	},
+}
+
+func findPullRegistry(ctx context.Context, cl *client.Client, image string, ref string) (*types.Registry, error) {
+	registries, err := cl.ListRegistries(ctx)
+	if err != nil {
+		// Only administrators can list registries; anonymous pulls still work
+		if ref == "" {
+			return nil, nil
+		}
+		return nil, apiError(err, "list registries", "registry")
+	}
+
+	if ref != "" {
+		registry := matchRegistryRef(registries, ref)
+		if registry == nil {
+			return nil, fmt.Errorf("registry %q not found", ref)
+		}
+		return registry, nil
+	}
+
+	return matchRegistryHost(registries, client.ImageRegistryHost(image)), nil
+}
+
+func matchRegistryHost(registries []types.Registry, host string) *types.Registry {
+	for _, registry := range registries {
+		if !registry.Authentication {
+			continue
+		}
+
+		registryHost := registry.URL
+		registryHost = strings.TrimPrefix(registryHost, "https://")
+		registryHost = strings.TrimPrefix(registryHost, "http://")
+		registryHost, _, _ = strings.Cut(registryHost, "/")
+
+		if strings.EqualFold(registryHost, host) {
+			return &registry
+		}
+	}
+
+	return nil
+}
+
+func printPullProgress(message types.JSONMessage) {
+	// Byte-level download updates would flood CI logs
+	if message.ProgressDetail != nil && message.ProgressDetail.Total > 0 {
+		return
+	}
+
+	if message.ID != "" {
+		fmt.Printf("%s: %s\n", message.ID, message.Status)
+		return
+	}
+	fmt.Println(message.Status)
+}
+
+func init() {
+	imagesPullCmd.Flags().StringVar(&imagesPullRegistry, "registry", "", "Portainer registry name or ID providing the credentials")
+}
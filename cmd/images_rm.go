@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	imagesRmForce   bool
+	imagesRmNoPrune bool
+)
+
+var imagesRmCmd = &cobra.Command{
+	Use:     "rm [image...]",
+	Aliases: []string{"remove"},
+	Short:   "Remove one or more images",
+	Long: `Remove one or more images referenced by ID or repository:tag.
+
+Examples:
+  # Remove an image
+  portainer images rm nginx:1.25
+
+  # Force the removal of an image with several tags
+  portainer images rm 3f2a1b9c8d7e --force`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, endpointID, err := newNodeClient(cmd, imagesNode)
+		if err != nil {
+			return err
+		}
+
+		failed := 0
+		for _, image := range args {
+			items, err := cl.RemoveImage(cmd.Context(), endpointID, image, imagesRmForce, imagesRmNoPrune)
+			if err != nil {
+				fmt.Printf("Error: %s: %v\n", image, apiError(err, "remove image", "image"))
+				failed++
+				continue
+			}
+
+			for _, item := range items {
+				if item.Untagged != "" {
+					fmt.Printf("Untagged: %s\n", item.Untagged)
+				}
+				if item.Deleted != "" {
+					fmt.Printf("Deleted: %s\n", item.Deleted)
+				}
+			}
+		}
+
+		if failed > 0 {
+			return fmt.Errorf("failed to remove %d of %d images", failed, len(args))
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	imagesRmCmd.Flags().BoolVarP(&imagesRmForce, "force", "f", false, "Force the removal of the image")
+	imagesRmCmd.Flags().BoolVar(&imagesRmNoPrune, "no-prune", false, "Do not delete untagged parent images")
+}
@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pdrhp/portainer-go-cli/internal/client"
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImagePruneCandidates(t *testing.T) {
+	images := []types.ImageDetails{
+		{Image: types.Image{ID: "dangling", RepoTags: []string{"<none>:<none>"}}},
+		{Image: types.Image{ID: "dangling-used", RepoTags: nil}, UsedBy: []string{"web"}},
+		{Image: types.Image{ID: "tagged", RepoTags: []string{"nginx:1.27"}}},
+		{Image: types.Image{ID: "tagged-used", RepoTags: []string{"nginx:1.25"}}, UsedBy: []string{"proxy"}},
+	}
+
+	dangling := imagePruneCandidates(images, false)
+	require.Len(t, dangling, 1)
+	assert.Equal(t, "dangling", dangling[0].ID)
+
+	all := imagePruneCandidates(images, true)
+	require.Len(t, all, 2)
+	assert.Equal(t, "dangling", all[0].ID)
+	assert.Equal(t, "tagged", all[1].ID)
+}
+
+func TestMatchRegistryHost(t *testing.T) {
+	registries := []types.Registry{
+		{ID: 1, Name: "public", URL: "registry.example.com", Authentication: false},
+		{ID: 2, Name: "team", URL: "https://registry.example.com/v2", Authentication: true},
+		{ID: 3, Name: "hub", URL: "docker.io", Authentication: true},
+	}
+
+	registry := matchRegistryHost(registries, "registry.example.com")
+	require.NotNil(t, registry)
+	assert.Equal(t, 2, registry.ID)
+
+	registry = matchRegistryHost(registries, "docker.io")
+	require.NotNil(t, registry)
+	assert.Equal(t, 3, registry.ID)
+
+	assert.Nil(t, matchRegistryHost(registries, "ghcr.io"))
+}
+
+func TestAgentNodeNames(t *testing.T) {
+	endpoint := `{"Id":2,"Name":"prod-swarm","Type":2,"Snapshots":[{"Swarm":true}]}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/endpoints/2":
+			w.Write([]byte(endpoint))
+		case "/api/endpoints/2/docker/nodes":
+			w.Write([]byte(`[
+				{"ID":"a","Description":{"Hostname":"manager-1"},"Status":{"State":"ready"}},
+				{"ID":"b","Description":{"Hostname":"worker-1"},"Status":{"State":"down"}},
+				{"ID":"c","Description":{"Hostname":"worker-2"},"Status":{"State":"ready"}}
+			]`))
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	cl := client.New(server.URL)
+	cl.SetToken("test-token")
+
+	nodes, err := agentNodeNames(context.Background(), cl, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"manager-1", "worker-2"}, nodes)
+
+	// Without the agent the Docker API of the manager is the only target
+	endpoint = `{"Id":2,"Name":"prod-swarm","Type":1,"Snapshots":[{"Swarm":true}]}`
+	nodes, err = agentNodeNames(context.Background(), cl, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{""}, nodes)
+}
@@ -0,0 +1,303 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/pdrhp/portainer-go-cli/internal/client"
+	"github.com/pdrhp/portainer-go-cli/internal/printer"
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var (
+	k8sNamespacesCreateLabel []string
+	k8sAppsListNamespace     string
+	k8sAppsListStack         string
+)
+
+var k8sCmd = &cobra.Command{
+	Use:     "k8s",
+	Aliases: []string{"kubernetes"},
+	Short:   "Manage Kubernetes environments",
+	Long:    `Manage namespaces and applications of a Kubernetes environment through the Portainer Kubernetes proxy`,
+}
+
+var k8sNamespacesCmd = &cobra.Command{
+	Use:     "namespaces",
+	Aliases: []string{"ns"},
+	Short:   "Manage namespaces",
+	Long:    `List, create and delete the namespaces of a Kubernetes environment`,
+}
+
+var k8sAppsCmd = &cobra.Command{
+	Use:     "apps",
+	Aliases: []string{"applications"},
+	Short:   "Inspect applications",
+	Long:    `Inspect the deployments, statefulsets and daemonsets running on a Kubernetes environment`,
+}
+
+func init() {
+	k8sCmd.AddCommand(k8sNamespacesCmd)
+	k8sCmd.AddCommand(k8sAppsCmd)
+
+	k8sNamespacesCmd.AddCommand(k8sNamespacesListCmd)
+	k8sNamespacesCmd.AddCommand(k8sNamespacesCreateCmd)
+	k8sNamespacesCmd.AddCommand(k8sNamespacesDeleteCmd)
+
+	k8sAppsCmd.AddCommand(k8sAppsListCmd)
+
+	k8sNamespacesCreateCmd.Flags().StringArrayVar(&k8sNamespacesCreateLabel, "label", nil, "Namespace label in KEY=VALUE format (can be used multiple times)")
+
+	k8sAppsListCmd.Flags().StringVarP(&k8sAppsListNamespace, "namespace", "n", "", "Only list applications of this namespace")
+	k8sAppsListCmd.Flags().StringVar(&k8sAppsListStack, "stack", "", "Only list applications deployed by this stack")
+}
+
+func newKubernetesClient(cmd *cobra.Command) (*client.Client, int, error) {
+	cl, cfg, err := newAPIClient(cmd)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	endpointID, err := requireEndpointID(cmd, cl, cfg)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	endpoint, err := cl.GetEndpoint(cmd.Context(), endpointID)
+	if err != nil {
+		return nil, 0, apiError(err, "get endpoint", "endpoint")
+	}
+	if !endpoint.IsKubernetes() {
+		return nil, 0, fmt.Errorf("endpoint '%s' is not a Kubernetes environment (type: %s)", endpoint.Name, endpoint.Type)
+	}
+
+	return cl, endpointID, nil
+}
+
+func listKubernetesApplications(ctx context.Context, cl *client.Client, endpointID int, namespace string) ([]types.KubernetesApplication, error) {
+	deployments, err := cl.ListDeployments(ctx, endpointID, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	statefulSets, err := cl.ListStatefulSets(ctx, endpointID, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	daemonSets, err := cl.ListDaemonSets(ctx, endpointID, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return kubernetesApplications(deployments, statefulSets, daemonSets), nil
+}
+
+func kubernetesApplications(deployments []types.KubernetesDeployment, statefulSets []types.KubernetesStatefulSet, daemonSets []types.KubernetesDaemonSet) []types.KubernetesApplication {
+	var apps []types.KubernetesApplication
+	newApp := func(kind string, meta types.KubernetesObjectMeta, template types.KubernetesPodTemplate) types.KubernetesApplication {
+		return types.KubernetesApplication{
+			Name:      meta.Name,
+			Namespace: meta.Namespace,
+			Kind:      kind,
+			Image:     template.Image(),
+			Stack:     meta.Labels[types.KubernetesStackLabel],
+			Created:   meta.CreationTimestamp,
+
+			Generation: meta.Generation,
+		}
+	}
+
+	for _, deployment := range deployments {
+		app := newApp("Deployment", deployment.Metadata, deployment.Spec.Template)
+		app.Ready = deployment.Status.ReadyReplicas
+		app.Desired = deployment.Spec.DesiredReplicas()
+		app.Updated = deployment.Status.UpdatedReplicas
+		app.Current = deployment.Status.Replicas
+		app.ObservedGeneration = deployment.Status.ObservedGeneration
+		apps = append(apps, app)
+	}
+
+	for _, statefulSet := range statefulSets {
+		app := newApp("StatefulSet", statefulSet.Metadata, statefulSet.Spec.Template)
+		app.Ready = statefulSet.Status.ReadyReplicas
+		app.Desired = statefulSet.Spec.DesiredReplicas()
+		app.Updated = statefulSet.Status.UpdatedReplicas
+		app.Current = statefulSet.Status.Replicas
+		app.ObservedGeneration = statefulSet.Status.ObservedGeneration
+		apps = append(apps, app)
+	}
+
+	for _, daemonSet := range daemonSets {
+		app := newApp("DaemonSet", daemonSet.Metadata, daemonSet.Spec.Template)
+		app.Ready = daemonSet.Status.NumberReady
+		app.Desired = daemonSet.Status.DesiredNumberScheduled
+		app.Updated = daemonSet.Status.UpdatedNumberScheduled
+		app.Current = daemonSet.Status.CurrentNumberScheduled
+		app.ObservedGeneration = daemonSet.Status.ObservedGeneration
+		apps = append(apps, app)
+	}
+
+	sort.Slice(apps, func(i, j int) bool {
+		if apps[i].Namespace != apps[j].Namespace {
+			return apps[i].Namespace < apps[j].Namespace
+		}
+		return apps[i].Name < apps[j].Name
+	})
+
+	return apps
+}
+
+var k8sNamespacesListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List namespaces",
+	Long: `List the namespaces of a Kubernetes environment.
+
+Examples:
+  # List namespaces of the default endpoint
+  portainer k8s namespaces list
+
+  # Output in JSON format
+  portainer k8s namespaces list --endpoint prod-k8s --output json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, endpointID, err := newKubernetesClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		namespaces, err := cl.ListNamespaces(cmd.Context(), endpointID)
+		if err != nil {
+			return apiError(err, "list namespaces", "endpoint")
+		}
+
+		if len(namespaces) == 0 {
+			fmt.Println("No namespaces found.")
+			return nil
+		}
+
+		outputFormat := cmd.Flag("output").Value.String()
+
+		return printer.PrintKubernetesNamespaces(namespaces, outputFormat)
+	},
+}
+
+var k8sNamespacesCreateCmd = &cobra.Command{
+	Use:   "create [name]",
+	Short: "Create a namespace",
+	Long: `Create a namespace on a Kubernetes environment.
+
+Examples:
+  # Create a namespace
+  portainer k8s namespaces create payments --endpoint prod-k8s
+
+  # Create a namespace with labels
+  portainer k8s namespaces create payments --label team=payments --label env=prod`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		labels, err := parseKeyValueFlags(k8sNamespacesCreateLabel, "label")
+		if err != nil {
+			return err
+		}
+
+		cl, endpointID, err := newKubernetesClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		namespace, err := cl.CreateNamespace(cmd.Context(), endpointID, args[0], labels)
+		if err != nil {
+			return apiError(err, "create namespace", "endpoint")
+		}
+
+		fmt.Printf("Namespace '%s' created\n", namespace.Metadata.Name)
+		return nil
+	},
+}
+
+var k8sNamespacesDeleteCmd = &cobra.Command{
+	Use:     "delete [name...]",
+	Aliases: []string{"rm"},
+	Short:   "Delete one or more namespaces",
+	Long: `Delete one or more namespaces and everything running in them. System namespaces cannot be deleted.
+
+Examples:
+  # Delete namespaces
+  portainer k8s namespaces delete payments-staging payments-preview`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, endpointID, err := newKubernetesClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		failed := 0
+		for _, name := range args {
+			if slices.Contains(types.KubernetesSystemNamespaces, name) {
+				fmt.Printf("Error: %s: system namespaces cannot be deleted\n", name)
+				failed++
+				continue
+			}
+			if err := cl.DeleteNamespace(cmd.Context(), endpointID, name); err != nil {
+				fmt.Printf("Error: %s: %v\n", name, apiError(err, "delete namespace", "namespace"))
+				failed++
+				continue
+			}
+			fmt.Printf("Deleted namespace %s\n", name)
+		}
+
+		if failed > 0 {
+			return fmt.Errorf("failed to delete %d of %d namespaces", failed, len(args))
+		}
+
+		return nil
+	},
+}
+
+var k8sAppsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List applications",
+	Long: `List deployments, statefulsets and daemonsets with their replica readiness and the stack that deployed them.
+
+Examples:
+  # List applications of all namespaces
+  portainer k8s apps list --endpoint prod-k8s
+
+  # List applications of a namespace
+  portainer k8s apps list --namespace payments
+
+  # List applications deployed by a stack
+  portainer k8s apps list --stack payments-api --output json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, endpointID, err := newKubernetesClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		apps, err := listKubernetesApplications(cmd.Context(), cl, endpointID, k8sAppsListNamespace)
+		if err != nil {
+			return apiError(err, "list applications", "namespace")
+		}
+
+		if k8sAppsListStack != "" {
+			var filtered []types.KubernetesApplication
+			for _, app := range apps {
+				if app.Stack == k8sAppsListStack {
+					filtered = append(filtered, app)
+				}
+			}
+			apps = filtered
+		}
+
+		if len(apps) == 0 {
+			fmt.Println("No applications found.")
+			return nil
+		}
+
+		outputFormat := cmd.Flag("output").Value.String()
+
+		return printer.PrintKubernetesApplications(apps, outputFormat)
+	},
+}
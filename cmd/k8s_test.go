@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKubernetesApplications(t *testing.T) {
+	three := 3
+	deployment := types.KubernetesDeployment{
+		Metadata: types.KubernetesObjectMeta{
+			Name:      "api",
+			Namespace: "payments",
+			Labels:    map[string]string{types.KubernetesStackLabel: "payments-api"},
+		},
+		Spec: types.KubernetesWorkloadSpec{Replicas: &three},
+	}
+	deployment.Spec.Template.Spec.Containers = []types.KubernetesContainer{{Name: "api", Image: "registry.local/api:1.2"}}
+	deployment.Status.ReadyReplicas = 2
+
+	statefulSet := types.KubernetesStatefulSet{
+		Metadata: types.KubernetesObjectMeta{Name: "db", Namespace: "payments"},
+	}
+	statefulSet.Status.ReadyReplicas = 1
+
+	daemonSet := types.KubernetesDaemonSet{
+		Metadata: types.KubernetesObjectMeta{Name: "agent", Namespace: "monitoring"},
+	}
+	daemonSet.Status.DesiredNumberScheduled = 4
+	daemonSet.Status.NumberReady = 4
+
+	apps := kubernetesApplications(
+		[]types.KubernetesDeployment{deployment},
+		[]types.KubernetesStatefulSet{statefulSet},
+		[]types.KubernetesDaemonSet{daemonSet},
+	)
+
+	require.Len(t, apps, 3)
+	assert.Equal(t, types.KubernetesApplication{Name: "agent", Namespace: "monitoring", Kind: "DaemonSet", Ready: 4, Desired: 4}, apps[0])
+	assert.Equal(t, types.KubernetesApplication{Name: "api", Namespace: "payments", Kind: "Deployment", Image: "registry.local/api:1.2", Ready: 2, Desired: 3, Stack: "payments-api"}, apps[1])
+	assert.Equal(t, types.KubernetesApplication{Name: "db", Namespace: "payments", Kind: "StatefulSet", Ready: 1, Desired: 1}, apps[2])
+}
@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/pdrhp/portainer-go-cli/internal/client"
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var networksNode string
+
+var networksCmd = &cobra.Command{
+	Use:   "networks",
+	Short: "Manage networks",
+	Long:  `Manage Docker networks of an environment through the Portainer Docker proxy`,
+}
+
+func init() {
+	networksCmd.PersistentFlags().StringVar(&networksNode, "node", "", "Target a specific swarm node (agent environments only)")
+
+	networksCmd.AddCommand(networksListCmd)
+	networksCmd.AddCommand(networksInspectCmd)
+	networksCmd.AddCommand(networksCreateCmd)
+	networksCmd.AddCommand(networksRmCmd)
+	networksCmd.AddCommand(networksPruneCmd)
+}
+
+func listNetworkDetails(ctx context.Context, cl *client.Client, endpointID int, stack string, unusedOnly bool) ([]types.NetworkDetails, error) {
+	unused, err := cl.ListNetworks(ctx, endpointID, &types.NetworkFilters{Stack: stack, Dangling: true})
+	if err != nil {
+		return nil, err
+	}
+
+	networks := unused
+	if !unusedOnly {
+		networks, err = cl.ListNetworks(ctx, endpointID, &types.NetworkFilters{Stack: stack})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	unusedIDs := map[string]bool{}
+	for _, network := range unused {
+		unusedIDs[network.ID] = true
+	}
+
+	details := make([]types.NetworkDetails, 0, len(networks))
+	for _, network := range networks {
+		details = append(details, types.NetworkDetails{
+			Network: network,
+			Unused:  unusedIDs[network.ID],
+		})
+	}
+
+	return details, nil
+}
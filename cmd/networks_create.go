@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var (
+	networksCreateDriver     string
+	networksCreateAttachable bool
+	networksCreateInternal   bool
+	networksCreateSubnet     string
+	networksCreateGateway    string
+	networksCreateLabels     []string
+	networksCreateOpts       []string
+)
+
+var networksCreateCmd = &cobra.Command{
+	Use:   "create [name]",
+	Short: "Create a network",
+	Long: `Create a network on an environment.
+
+Examples:
+  # Create an attachable overlay network shared by several stacks
+  portainer networks create shared-proxy --driver overlay --attachable
+
+  # Create a bridge network with a fixed subnet
+  portainer networks create backend --subnet 172.28.0.0/16 --gateway 172.28.0.1 --label team=payments`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if networksCreateGateway != "" && networksCreateSubnet == "" {
+			return fmt.Errorf("--gateway requires --subnet")
+		}
+
+		labels, err := parseKeyValueFlags(networksCreateLabels, "label")
+		if err != nil {
+			return err
+		}
+
+		opts, err := parseKeyValueFlags(networksCreateOpts, "opt")
+		if err != nil {
+			return err
+		}
+
+		request := types.NetworkCreateRequest{
+			Name:       args[0],
+			Driver:     networksCreateDriver,
+			Internal:   networksCreateInternal,
+			Attachable: networksCreateAttachable,
+			Labels:     labels,
+			Options:    opts,
+		}
+		if networksCreateSubnet != "" {
+			request.IPAM = &types.NetworkIPAM{
+				Config: []types.NetworkIPAMConfig{{Subnet: networksCreateSubnet, Gateway: networksCreateGateway}},
+			}
+		}
+
+		cl, endpointID, err := newNodeClient(cmd, networksNode)
+		if err != nil {
+			return err
+		}
+
+		response, err := cl.CreateNetwork(cmd.Context(), endpointID, request)
+		if err != nil {
+			return apiError(err, "create network", "endpoint")
+		}
+
+		if response.Warning != "" {
+			fmt.Printf("Warning: %s\n", response.Warning)
+		}
+		fmt.Printf("Network %s created successfully (ID: %s)\n", args[0], response.ID)
+		return nil
+	},
+}
+
+func init() {
+	networksCreateCmd.Flags().StringVar(&networksCreateDriver, "driver", "", "Network driver, e.g. bridge or overlay (default: bridge)")
+	networksCreateCmd.Flags().BoolVar(&networksCreateAttachable, "attachable", false, "Allow standalone containers to attach to the overlay network")
+	networksCreateCmd.Flags().BoolVar(&networksCreateInternal, "internal", false, "Restrict external access to the network")
+	networksCreateCmd.Flags().StringVar(&networksCreateSubnet, "subnet", "", "Subnet in CIDR format")
+	networksCreateCmd.Flags().StringVar(&networksCreateGateway, "gateway", "", "Gateway for the subnet")
+	networksCreateCmd.Flags().StringArrayVar(&networksCreateLabels, "label", []string{}, "Network label (format: KEY=value, can be used multiple times)")
+	networksCreateCmd.Flags().StringArrayVar(&networksCreateOpts, "opt", []string{}, "Driver option (format: KEY=value, can be used multiple times)")
+}
@@ -0,0 +1,35 @@
+package cmd
+
+import (
+	"github.com/pdrhp/portainer-go-cli/internal/printer"
+	"github.com/spf13/cobra"
+)
+
+var networksInspectCmd = &cobra.Command{
+	Use:   "inspect [network]",
+	Short: "Show details of a network",
+	Long: `Show details of a network referenced by ID or name, including attached containers.
+
+Examples:
+  # Inspect a network
+  portainer networks inspect web_default
+
+  # Output in JSON format
+  portainer networks inspect 7d86d31b1478 --output json`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, endpointID, err := newNodeClient(cmd, networksNode)
+		if err != nil {
+			return err
+		}
+
+		network, err := cl.InspectNetwork(cmd.Context(), endpointID, args[0])
+		if err != nil {
+			return apiError(err, "inspect network", "network")
+		}
+
+		outputFormat := cmd.Flag("output").Value.String()
+
+		return printer.PrintNetwork(*network, outputFormat)
+	},
+}
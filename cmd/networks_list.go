@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/internal/printer"
+	"github.com/spf13/cobra"
+)
+
+var (
+	networksListStack  string
+	networksListUnused bool
+)
+
+var networksListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List networks",
+	Long: `List networks of an environment with the stack they belong to and whether they are in use.
+
+The stack is read from the Swarm (com.docker.stack.namespace) or Compose
+(com.docker.compose.project) labels. A network is in use while a container or
+a swarm service is attached to it.
+
+Examples:
+  # List networks of the default endpoint
+  portainer networks list
+
+  # List overlay networks left behind by a removed stack
+  portainer networks list --stack web --unused
+
+  # Output in JSON format
+  portainer networks list --output json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, endpointID, err := newNodeClient(cmd, networksNode)
+		if err != nil {
+			return err
+		}
+
+		networks, err := listNetworkDetails(cmd.Context(), cl, endpointID, networksListStack, networksListUnused)
+		if err != nil {
+			return apiError(err, "list networks", "endpoint")
+		}
+
+		if len(networks) == 0 {
+			fmt.Println("No networks found.")
+			return nil
+		}
+
+		outputFormat := cmd.Flag("output").Value.String()
+
+		return printer.PrintNetworks(networks, outputFormat)
+	},
+}
+
+func init() {
+	networksListCmd.Flags().StringVar(&networksListStack, "stack", "", "Filter networks by stack name")
+	networksListCmd.Flags().BoolVar(&networksListUnused, "unused", false, "Show only networks without containers or services attached")
+}
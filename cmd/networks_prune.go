@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/internal/printer"
+	"github.com/spf13/cobra"
+)
+
+var (
+	networksPruneStack  string
+	networksPruneDryRun bool
+)
+
+var networksPruneCmd = &cobra.Command{
+	Use:   "prune",
+	Short: "Remove unused networks",
+	Long: `Remove every network without containers or services attached.
+
+Predefined networks (bridge, host, none, ingress...) are never removed.
+
+Examples:
+  # Preview the networks left behind by a removed stack
+  portainer networks prune --stack web --dry-run
+
+  # Remove the unused networks of a stack
+  portainer networks prune --stack web`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, endpointID, err := newNodeClient(cmd, networksNode)
+		if err != nil {
+			return err
+		}
+
+		candidates, err := listNetworkDetails(cmd.Context(), cl, endpointID, networksPruneStack, true)
+		if err != nil {
+			return apiError(err, "list networks", "endpoint")
+		}
+
+		if len(candidates) == 0 {
+			fmt.Println("No unused networks found.")
+			return nil
+		}
+
+		if networksPruneDryRun {
+			outputFormat := cmd.Flag("output").Value.String()
+			if err := printer.PrintNetworks(candidates, outputFormat); err != nil {
+				return err
+			}
+
+			if outputFormat != "json" && outputFormat != "yaml" {
+				fmt.Printf("\nWould remove %d networks\n", len(candidates))
+			}
+			return nil
+		}
+
+		failed := 0
+		for _, network := range candidates {
+			target := cl
+			if node := network.NodeName(); node != "" {
+				target = cl.ForNode(node)
+			}
+
+			if err := target.RemoveNetwork(cmd.Context(), endpointID, network.ID); err != nil {
+				fmt.Printf("Error: %s: %v\n", network.Name, apiError(err, "remove network", "network"))
+				failed++
+				continue
+			}
+			fmt.Printf("Deleted: %s\n", network.Name)
+		}
+
+		if failed > 0 {
+			return fmt.Errorf("failed to remove %d of %d networks", failed, len(candidates))
+		}
+
+		fmt.Printf("Removed %d networks\n", len(candidates))
+		return nil
+	},
+}
+
+func init() {
+	networksPruneCmd.Flags().StringVar(&networksPruneStack, "stack", "", "Only remove networks of this stack")
+	networksPruneCmd.Flags().BoolVar(&networksPruneDryRun, "dry-run", false, "Show the networks that would be removed without removing them")
+}
@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var networksRmCmd = &cobra.Command{
+	Use:     "rm [network...]",
+	Aliases: []string{"remove"},
+	Short:   "Remove one or more networks",
+	Long: `Remove one or more networks referenced by ID or name.
+
+Examples:
+  # Remove networks
+  portainer networks rm web_default web_backend`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, endpointID, err := newNodeClient(cmd, networksNode)
+		if err != nil {
+			return err
+		}
+
+		failed := 0
+		for _, network := range args {
+			if err := cl.RemoveNetwork(cmd.Context(), endpointID, network); err != nil {
+				fmt.Printf("Error: %s: %v\n", network, apiError(err, "remove network", "network"))
+				failed++
+				continue
+			}
+			fmt.Printf("Removed %s\n", network)
+		}
+
+		if failed > 0 {
+			return fmt.Errorf("failed to remove %d of %d networks", failed, len(args))
+		}
+
+		return nil
+	},
+}
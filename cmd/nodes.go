@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/internal/client"
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var nodesCmd = &cobra.Command{
+	Use:   "nodes",
+	Short: "Manage swarm nodes",
+	Long:  `Inspect and maintain the nodes of a swarm environment through the Portainer Docker proxy`,
+}
+
+func init() {
+	nodesCmd.AddCommand(nodesListCmd)
+	nodesCmd.AddCommand(nodesInspectCmd)
+	nodesCmd.AddCommand(nodesDrainCmd)
+	nodesCmd.AddCommand(nodesActivateCmd)
+	nodesCmd.AddCommand(nodesPauseCmd)
+	nodesCmd.AddCommand(nodesLabelCmd)
+}
+
+func listNodeDetails(ctx context.Context, cl *client.Client, endpointID int) ([]types.NodeDetails, error) {
+	nodes, err := cl.ListNodes(ctx, endpointID)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := cl.ListTasks(ctx, endpointID, &types.TaskFilters{DesiredState: "running"})
+	if err != nil {
+		return nil, err
+	}
+
+	running := map[string]int{}
+	for _, task := range tasks {
+		if task.Status.State == "running" {
+			running[task.NodeID]++
+		}
+	}
+
+	details := make([]types.NodeDetails, 0, len(nodes))
+	for _, node := range nodes {
+		details = append(details, types.NodeDetails{
+			Node:         node,
+			RunningTasks: running[node.ID],
+		})
+	}
+
+	return details, nil
+}
+
+// agentNodeNames returns the ready nodes of an agent swarm. Requests without a
+// target reach only the node the agent picks, so commands acting on every node
+// send them one by one; other environments get a single "" entry for the
+// environment itself.
+func agentNodeNames(ctx context.Context, cl *client.Client, endpointID int) ([]string, error) {
+	endpoint, err := cl.GetEndpoint(ctx, endpointID)
+	if err != nil {
+		return nil, apiError(err, "get endpoint", "endpoint")
+	}
+	if !endpoint.IsDockerAgent() || !endpoint.IsSwarm() {
+		return []string{""}, nil
+	}
+
+	nodes, err := cl.ListNodes(ctx, endpointID)
+	if err != nil {
+		return nil, apiError(err, "list nodes", "endpoint")
+	}
+
+	names := []string{}
+	for _, node := range nodes {
+		if node.Status.State == "ready" {
+			names = append(names, node.Description.Hostname)
+		}
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("no ready node found in swarm")
+	}
+
+	return names, nil
+}
@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pdrhp/portainer-go-cli/internal/client"
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var (
+	nodesDrainWait    bool
+	nodesDrainTimeout time.Duration
+)
+
+var nodesDrainCmd = &cobra.Command{
+	Use:   "drain [node...]",
+	Short: "Drain one or more nodes before maintenance",
+	Long: `Set the availability of one or more nodes to drain: swarm stops scheduling
+tasks on them and moves their running tasks to other nodes.
+
+Examples:
+  # Drain a node and wait until its tasks were moved
+  portainer nodes drain worker-2 --wait
+
+  # Drain several nodes without waiting
+  portainer nodes drain worker-2 worker-3`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, endpointID, err := setNodesAvailability(cmd, args, types.NodeAvailabilityDrain)
+		if err != nil {
+			return err
+		}
+
+		if !nodesDrainWait {
+			return nil
+		}
+
+		return waitForNodesDrain(cmd.Context(), cl, endpointID, args, nodesDrainTimeout)
+	},
+}
+
+var nodesActivateCmd = &cobra.Command{
+	Use:   "activate [node...]",
+	Short: "Make one or more nodes available for tasks again",
+	Long: `Set the availability of one or more nodes back to active after maintenance.
+
+Swarm does not move running tasks back to an activated node; use
+'portainer services update --force' to rebalance services.
+
+Examples:
+  # Activate a node
+  portainer nodes activate worker-2`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		_, _, err := setNodesAvailability(cmd, args, types.NodeAvailabilityActive)
+		return err
+	},
+}
+
+var nodesPauseCmd = &cobra.Command{
+	Use:   "pause [node...]",
+	Short: "Stop scheduling new tasks on one or more nodes",
+	Long: `Set the availability of one or more nodes to pause: running tasks keep running
+but no new task is scheduled on them.
+
+Examples:
+  # Pause a node
+  portainer nodes pause worker-2`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		_, _, err := setNodesAvailability(cmd, args, types.NodeAvailabilityPause)
+		return err
+	},
+}
+
+func setNodesAvailability(cmd *cobra.Command, nodes []string, availability types.NodeAvailability) (*client.Client, int, error) {
+	cl, cfg, err := newAPIClient(cmd)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	endpointID, err := requireEndpointID(cmd, cl, cfg)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for _, node := range nodes {
+		if err := cl.UpdateNode(cmd.Context(), endpointID, node, client.SetNodeAvailability(availability)); err != nil {
+			return nil, 0, apiError(err, "update node "+node, "node")
+		}
+		fmt.Printf("Node %s availability set to %s\n", node, availability)
+	}
+
+	return cl, endpointID, nil
+}
+
+func waitForNodesDrain(ctx context.Context, cl *client.Client, endpointID int, nodes []string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for _, node := range nodes {
+		fmt.Printf("Waiting for node %s to drain...\n", node)
+		if err := cl.WaitForNodeDrain(ctx, endpointID, node, servicePollInterval); err != nil {
+			return err
+		}
+		fmt.Printf("Node %s has no running tasks\n", node)
+	}
+
+	return nil
+}
+
+func init() {
+	nodesDrainCmd.Flags().BoolVar(&nodesDrainWait, "wait", false, "Wait until the nodes have no running tasks")
+	nodesDrainCmd.Flags().DurationVar(&nodesDrainTimeout, "timeout", 10*time.Minute, "Maximum time to wait for the nodes to drain")
+}
@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"github.com/pdrhp/portainer-go-cli/internal/printer"
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var nodesInspectCmd = &cobra.Command{
+	Use:   "inspect [node]",
+	Short: "Show details of a swarm node",
+	Long: `Show details of a swarm node referenced by ID or hostname.
+
+Examples:
+  # Inspect a node
+  portainer nodes inspect worker-2
+
+  # Output in JSON format
+  portainer nodes inspect worker-2 --output json`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, cfg, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		endpointID, err := requireEndpointID(cmd, cl, cfg)
+		if err != nil {
+			return err
+		}
+
+		node, err := cl.InspectNode(cmd.Context(), endpointID, args[0])
+		if err != nil {
+			return apiError(err, "inspect node", "node")
+		}
+
+		running, err := cl.CountRunningTasks(cmd.Context(), endpointID, node.ID)
+		if err != nil {
+			return apiError(err, "list tasks", "node")
+		}
+
+		outputFormat := cmd.Flag("output").Value.String()
+
+		return printer.PrintNode(types.NodeDetails{Node: *node, RunningTasks: running}, outputFormat)
+	},
+}
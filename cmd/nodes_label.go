@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/internal/client"
+	"github.com/spf13/cobra"
+)
+
+var nodesLabelCmd = &cobra.Command{
+	Use:   "label",
+	Short: "Manage node labels",
+	Long:  `Add or remove node labels used by service placement constraints (node.labels.<key>)`,
+}
+
+var nodesLabelAddCmd = &cobra.Command{
+	Use:   "add [node] [key=value...]",
+	Short: "Add or update labels of a node",
+	Long: `Add or update labels of a node.
+
+Examples:
+  # Label a node for placement constraints
+  portainer nodes label add worker-2 zone=eu-west-1a storage=ssd`,
+	Args: cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		labels, err := parseKeyValueArgs(args[1:])
+		if err != nil {
+			return err
+		}
+
+		cl, cfg, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		endpointID, err := requireEndpointID(cmd, cl, cfg)
+		if err != nil {
+			return err
+		}
+
+		if err := cl.UpdateNode(cmd.Context(), endpointID, args[0], client.SetNodeLabels(labels)); err != nil {
+			return apiError(err, "update node "+args[0], "node")
+		}
+
+		fmt.Printf("Node %s labels updated\n", args[0])
+		return nil
+	},
+}
+
+var nodesLabelRmCmd = &cobra.Command{
+	Use:     "rm [node] [key...]",
+	Aliases: []string{"remove"},
+	Short:   "Remove labels from a node",
+	Long: `Remove labels from a node.
+
+Examples:
+  # Remove a label
+  portainer nodes label rm worker-2 storage`,
+	Args: cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, cfg, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		endpointID, err := requireEndpointID(cmd, cl, cfg)
+		if err != nil {
+			return err
+		}
+
+		if err := cl.UpdateNode(cmd.Context(), endpointID, args[0], client.RemoveNodeLabels(args[1:])); err != nil {
+			return apiError(err, "update node "+args[0], "node")
+		}
+
+		fmt.Printf("Node %s labels removed\n", args[0])
+		return nil
+	},
+}
+
+func init() {
+	nodesLabelCmd.AddCommand(nodesLabelAddCmd)
+	nodesLabelCmd.AddCommand(nodesLabelRmCmd)
+}
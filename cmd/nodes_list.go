@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/internal/printer"
+	"github.com/spf13/cobra"
+)
+
+var nodesListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List swarm nodes",
+	Long: `List the nodes of a swarm environment with their role, status, availability
+and number of running tasks.
+
+Examples:
+  # List nodes of the default endpoint
+  portainer nodes list
+
+  # Output in JSON format
+  portainer nodes list --endpoint prod-swarm --output json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, cfg, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		endpointID, err := requireEndpointID(cmd, cl, cfg)
+		if err != nil {
+			return err
+		}
+
+		nodes, err := listNodeDetails(cmd.Context(), cl, endpointID)
+		if err != nil {
+			return apiError(err, "list nodes", "endpoint")
+		}
+
+		if len(nodes) == 0 {
+			fmt.Println("No nodes found.")
+			return nil
+		}
+
+		outputFormat := cmd.Flag("output").Value.String()
+
+		return printer.PrintNodes(nodes, outputFormat)
+	},
+}
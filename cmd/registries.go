@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pdrhp/portainer-go-cli/internal/client"
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+const registryPasswordEnv = "PORTAINER_REGISTRY_PASSWORD"
+
+var registriesCmd = &cobra.Command{
+	Use:   "registries",
+	Short: "Manage registries",
+	Long:  `Manage the container registries configured in Portainer and their access per environment`,
+}
+
+func init() {
+	registriesCmd.AddCommand(registriesListCmd)
+	registriesCmd.AddCommand(registriesInspectCmd)
+	registriesCmd.AddCommand(registriesCreateCmd)
+	registriesCmd.AddCommand(registriesUpdateCmd)
+	registriesCmd.AddCommand(registriesDeleteCmd)
+	registriesCmd.AddCommand(registriesAccessCmd)
+}
+
+func lookupRegistry(ctx context.Context, cl *client.Client, ref string) (*types.Registry, error) {
+	registries, err := cl.ListRegistries(ctx)
+	if err != nil {
+		return nil, apiError(err, "list registries", "registry")
+	}
+
+	registry := matchRegistryRef(registries, ref)
+	if registry == nil {
+		return nil, fmt.Errorf("registry %q not found", ref)
+	}
+
+	return registry, nil
+}
+
+func matchRegistryRef(registries []types.Registry, ref string) *types.Registry {
+	id, _ := strconv.Atoi(ref)
+	for _, registry := range registries {
+		if registry.Name == ref || (id > 0 && registry.ID == id) {
+			return &registry
+		}
+	}
+
+	return nil
+}
+
+func readRegistryPassword(cmd *cobra.Command, fromStdin bool) (string, error) {
+	if fromStdin {
+		data, err := io.ReadAll(cmd.InOrStdin())
+		if err != nil {
+			return "", fmt.Errorf("failed to read password from stdin: %w", err)
+		}
+		return strings.TrimRight(string(data), "\r\n"), nil
+	}
+
+	return os.Getenv(registryPasswordEnv), nil
+}
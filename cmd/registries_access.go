@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var (
+	registriesAccessUserIDs []int
+	registriesAccessTeamIDs []int
+	registriesAccessClear   bool
+)
+
+var registriesAccessCmd = &cobra.Command{
+	Use:   "access [registry]",
+	Short: "Configure which users and teams can use a registry on an environment",
+	Long: `Configure which users and teams can use a registry on an environment.
+
+The given users and teams replace the current access of the registry on the
+environment selected with --endpoint. Administrators always have access.
+The current access is shown by 'portainer registries inspect'.
+
+Examples:
+  # Allow a team and a user to pull from the registry on prod-swarm
+  portainer registries access gitlab --endpoint prod-swarm --team-id 2 --user-id 5
+
+  # Remove every access on an environment
+  portainer registries access gitlab --endpoint staging --clear`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if registriesAccessClear && (len(registriesAccessUserIDs) > 0 || len(registriesAccessTeamIDs) > 0) {
+			return fmt.Errorf("--clear cannot be used with --user-id or --team-id")
+		}
+		if !registriesAccessClear && len(registriesAccessUserIDs) == 0 && len(registriesAccessTeamIDs) == 0 {
+			return fmt.Errorf("no access given. Use --user-id, --team-id or --clear")
+		}
+
+		cl, cfg, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		endpointID, err := requireEndpointID(cmd, cl, cfg)
+		if err != nil {
+			return err
+		}
+
+		registry, err := lookupRegistry(cmd.Context(), cl, args[0])
+		if err != nil {
+			return err
+		}
+
+		policies := types.RegistryAccessPolicies{
+			UserAccessPolicies: map[int]types.AccessPolicy{},
+			TeamAccessPolicies: map[int]types.AccessPolicy{},
+		}
+		for _, userID := range registriesAccessUserIDs {
+			policies.UserAccessPolicies[userID] = types.AccessPolicy{}
+		}
+		for _, teamID := range registriesAccessTeamIDs {
+			policies.TeamAccessPolicies[teamID] = types.AccessPolicy{}
+		}
+
+		// Kubernetes namespaces granted in the UI are kept
+		if current, ok := registry.RegistryAccesses[endpointID]; ok {
+			policies.Namespaces = current.Namespaces
+		}
+
+		if err := cl.UpdateEndpointRegistryAccess(cmd.Context(), endpointID, registry.ID, policies); err != nil {
+			return apiError(err, "update registry access", "registry")
+		}
+
+		fmt.Printf("Access to registry '%s' on endpoint %d updated (%d users, %d teams)\n",
+			registry.Name, endpointID, len(policies.UserAccessPolicies), len(policies.TeamAccessPolicies))
+		return nil
+	},
+}
+
+func init() {
+	registriesAccessCmd.Flags().IntSliceVar(&registriesAccessUserIDs, "user-id", []int{}, "ID of a user allowed to use the registry (can be used multiple times)")
+	registriesAccessCmd.Flags().IntSliceVar(&registriesAccessTeamIDs, "team-id", []int{}, "ID of a team allowed to use the registry (can be used multiple times)")
+	registriesAccessCmd.Flags().BoolVar(&registriesAccessClear, "clear", false, "Remove every user and team access on the environment")
+}
@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var ecrRegionPattern = regexp.MustCompile(`\.dkr\.ecr\.([a-z0-9-]+)\.amazonaws\.com`)
+
+var defaultRegistryURLs = map[types.RegistryType]string{
+	types.RegistryTypeDockerHub: "docker.io",
+	types.RegistryTypeGitlab:    "registry.gitlab.com",
+	types.RegistryTypeGithub:    "ghcr.io",
+	types.RegistryTypeQuay:      "quay.io",
+}
+
+var (
+	registriesCreateName          string
+	registriesCreateType          string
+	registriesCreateURL           string
+	registriesCreateUsername      string
+	registriesCreatePasswordStdin bool
+	registriesCreateRegion        string
+)
+
+var registriesCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a registry",
+	Long: `Create a registry in Portainer.
+
+The password (or token, or AWS secret access key for ECR) is read from stdin
+with --password-stdin, or from the PORTAINER_REGISTRY_PASSWORD environment
+variable. It is never accepted as a flag.
+
+Supported types: dockerhub, gitlab, github, ecr, quay, azure, proget, custom.
+
+Examples:
+  # DockerHub with an access token from the environment
+  PORTAINER_REGISTRY_PASSWORD=$DOCKERHUB_TOKEN portainer registries create --name dockerhub-team --type dockerhub --username team
+
+  # GitLab registry with a deploy token from stdin
+  echo "$GITLAB_TOKEN" | portainer registries create --name gitlab --type gitlab --username deploy-bot --password-stdin
+
+  # Amazon ECR (the region is read from the URL)
+  portainer registries create --name ecr-prod --type ecr --url 123456789012.dkr.ecr.eu-west-1.amazonaws.com --username AKIA... --password-stdin
+
+  # Anonymous custom registry
+  portainer registries create --name mirror --url registry.internal:5000`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if registriesCreateName == "" {
+			return fmt.Errorf("flag --name is required")
+		}
+
+		registryType, ok := types.ParseRegistryType(registriesCreateType)
+		if !ok {
+			return fmt.Errorf("invalid registry type %q", registriesCreateType)
+		}
+
+		url := registriesCreateURL
+		if url == "" {
+			url = defaultRegistryURLs[registryType]
+		}
+		if url == "" {
+			return fmt.Errorf("--url is required for %s registries", registryType)
+		}
+
+		password, err := readRegistryPassword(cmd, registriesCreatePasswordStdin)
+		if err != nil {
+			return err
+		}
+		if registriesCreateUsername != "" && password == "" {
+			return fmt.Errorf("password not provided. Use --password-stdin or set %s", registryPasswordEnv)
+		}
+		if registriesCreateUsername == "" && password != "" {
+			return fmt.Errorf("--username is required when a password is provided")
+		}
+
+		request := types.RegistryCreateRequest{
+			Name:           registriesCreateName,
+			Type:           registryType,
+			URL:            url,
+			Authentication: registriesCreateUsername != "",
+			Username:       registriesCreateUsername,
+			Password:       password,
+		}
+
+		if registryType == types.RegistryTypeECR {
+			region := registriesCreateRegion
+			if region == "" {
+				if match := ecrRegionPattern.FindStringSubmatch(url); match != nil {
+					region = match[1]
+				}
+			}
+			if region == "" {
+				return fmt.Errorf("--region is required for ECR registries")
+			}
+			request.Ecr = &types.RegistryEcrData{Region: region}
+		}
+
+		cl, _, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		registry, err := cl.CreateRegistry(cmd.Context(), request)
+		if err != nil {
+			return apiError(err, "create registry", "registry")
+		}
+
+		fmt.Printf("Registry '%s' created successfully (ID: %d)\n", registry.Name, registry.ID)
+		return nil
+	},
+}
+
+func init() {
+	registriesCreateCmd.Flags().StringVar(&registriesCreateName, "name", "", "Registry name (required)")
+	registriesCreateCmd.Flags().StringVar(&registriesCreateType, "type", "custom", "Registry type: dockerhub, gitlab, github, ecr, quay, azure, proget, custom")
+	registriesCreateCmd.Flags().StringVar(&registriesCreateURL, "url", "", "Registry URL (defaults to the public registry of the type)")
+	registriesCreateCmd.Flags().StringVar(&registriesCreateUsername, "username", "", "Registry username (enables authentication)")
+	registriesCreateCmd.Flags().BoolVar(&registriesCreatePasswordStdin, "password-stdin", false, "Read the password from stdin")
+	registriesCreateCmd.Flags().StringVar(&registriesCreateRegion, "region", "", "AWS region of an ECR registry (default: read from the URL)")
+}
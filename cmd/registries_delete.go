@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var registriesDeleteCmd = &cobra.Command{
+	Use:     "delete [registry...]",
+	Aliases: []string{"rm"},
+	Short:   "Delete one or more registries",
+	Long: `Delete one or more registries referenced by name or ID.
+
+Examples:
+  # Delete a registry
+  portainer registries delete old-mirror`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, _, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		failed := 0
+		for _, ref := range args {
+			registry, err := lookupRegistry(cmd.Context(), cl, ref)
+			if err == nil {
+				err = cl.DeleteRegistry(cmd.Context(), registry.ID)
+				if err != nil {
+					err = apiError(err, "delete registry", "registry")
+				}
+			}
+			if err != nil {
+				fmt.Printf("Error: %s: %v\n", ref, err)
+				failed++
+				continue
+			}
+			fmt.Printf("Deleted %s\n", ref)
+		}
+
+		if failed > 0 {
+			return fmt.Errorf("failed to delete %d of %d registries", failed, len(args))
+		}
+
+		return nil
+	},
+}
@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"github.com/pdrhp/portainer-go-cli/internal/printer"
+	"github.com/spf13/cobra"
+)
+
+var registriesInspectCmd = &cobra.Command{
+	Use:   "inspect [registry]",
+	Short: "Show details of a registry",
+	Long: `Show details of a registry referenced by name or ID, including its access per environment.
+
+Examples:
+  # Inspect a registry
+  portainer registries inspect gitlab-team
+
+  # Output in JSON format
+  portainer registries inspect 3 --output json`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, _, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		found, err := lookupRegistry(cmd.Context(), cl, args[0])
+		if err != nil {
+			return err
+		}
+
+		registry, err := cl.GetRegistry(cmd.Context(), found.ID)
+		if err != nil {
+			return apiError(err, "get registry", "registry")
+		}
+
+		outputFormat := cmd.Flag("output").Value.String()
+
+		return printer.PrintRegistry(*registry, outputFormat)
+	},
+}
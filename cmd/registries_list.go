@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/internal/printer"
+	"github.com/spf13/cobra"
+)
+
+var registriesListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List registries",
+	Long: `List the registries configured in Portainer.
+
+Examples:
+  # List registries
+  portainer registries list
+
+  # Output in JSON format
+  portainer registries list --output json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, _, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		registries, err := cl.ListRegistries(cmd.Context())
+		if err != nil {
+			return apiError(err, "list registries", "registry")
+		}
+
+		if len(registries) == 0 {
+			fmt.Println("No registries found.")
+			return nil
+		}
+
+		outputFormat := cmd.Flag("output").Value.String()
+
+		return printer.PrintRegistries(registries, outputFormat)
+	},
+}
@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadRegistryPassword(t *testing.T) {
+	t.Setenv(registryPasswordEnv, "from-env")
+
+	cmd := &cobra.Command{}
+	cmd.SetIn(strings.NewReader("from-stdin\n"))
+
+	password, err := readRegistryPassword(cmd, true)
+	require.NoError(t, err)
+	assert.Equal(t, "from-stdin", password)
+
+	password, err = readRegistryPassword(cmd, false)
+	require.NoError(t, err)
+	assert.Equal(t, "from-env", password)
+}
+
+func TestMatchRegistryRef(t *testing.T) {
+	registries := []types.Registry{
+		{ID: 1, Name: "dockerhub-team"},
+		{ID: 4, Name: "4-mirror"},
+	}
+
+	assert.Equal(t, 1, matchRegistryRef(registries, "dockerhub-team").ID)
+	assert.Equal(t, 4, matchRegistryRef(registries, "4").ID)
+	assert.Equal(t, 4, matchRegistryRef(registries, "4-mirror").ID)
+	assert.Nil(t, matchRegistryRef(registries, "gitlab"))
+}
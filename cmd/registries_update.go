@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var (
+	registriesUpdateName          string
+	registriesUpdateURL           string
+	registriesUpdateUsername      string
+	registriesUpdatePasswordStdin bool
+	registriesUpdateNoAuth        bool
+)
+
+var registriesUpdateCmd = &cobra.Command{
+	Use:   "update [registry]",
+	Short: "Update a registry",
+	Long: `Update a registry referenced by name or ID. Only the given fields are changed.
+
+A new password is read from stdin with --password-stdin, or from the
+PORTAINER_REGISTRY_PASSWORD environment variable when --username is given.
+
+Examples:
+  # Rotate the token of a registry
+  echo "$NEW_TOKEN" | portainer registries update gitlab --password-stdin
+
+  # Change the credentials
+  PORTAINER_REGISTRY_PASSWORD=$TOKEN portainer registries update dockerhub-team --username ci-bot
+
+  # Turn a registry into an anonymous mirror
+  portainer registries update mirror --no-auth`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if registriesUpdateNoAuth && (registriesUpdateUsername != "" || registriesUpdatePasswordStdin) {
+			return fmt.Errorf("--no-auth cannot be used with --username or --password-stdin")
+		}
+
+		request := types.RegistryUpdateRequest{}
+		if cmd.Flags().Changed("name") {
+			request.Name = &registriesUpdateName
+		}
+		if cmd.Flags().Changed("url") {
+			request.URL = &registriesUpdateURL
+		}
+
+		if registriesUpdateUsername != "" || registriesUpdatePasswordStdin {
+			password, err := readRegistryPassword(cmd, registriesUpdatePasswordStdin)
+			if err != nil {
+				return err
+			}
+			if password == "" {
+				return fmt.Errorf("password not provided. Use --password-stdin or set %s", registryPasswordEnv)
+			}
+
+			authentication := true
+			request.Authentication = &authentication
+			request.Password = &password
+			if registriesUpdateUsername != "" {
+				request.Username = &registriesUpdateUsername
+			}
+		}
+
+		if registriesUpdateNoAuth {
+			authentication := false
+			request.Authentication = &authentication
+		}
+
+		if request == (types.RegistryUpdateRequest{}) {
+			return fmt.Errorf("nothing to update. Use --name, --url, --username, --password-stdin or --no-auth")
+		}
+
+		cl, _, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		registry, err := lookupRegistry(cmd.Context(), cl, args[0])
+		if err != nil {
+			return err
+		}
+
+		if registry.Type == types.RegistryTypeECR && registry.Ecr != nil {
+			request.Ecr = registry.Ecr
+		}
+
+		updated, err := cl.UpdateRegistry(cmd.Context(), registry.ID, request)
+		if err != nil {
+			return apiError(err, "update registry", "registry")
+		}
+
+		fmt.Printf("Registry '%s' updated successfully\n", updated.Name)
+		return nil
+	},
+}
+
+func init() {
+	registriesUpdateCmd.Flags().StringVar(&registriesUpdateName, "name", "", "New registry name")
+	registriesUpdateCmd.Flags().StringVar(&registriesUpdateURL, "url", "", "New registry URL")
+	registriesUpdateCmd.Flags().StringVar(&registriesUpdateUsername, "username", "", "New registry username")
+	registriesUpdateCmd.Flags().BoolVar(&registriesUpdatePasswordStdin, "password-stdin", false, "Read the new password from stdin")
+	registriesUpdateCmd.Flags().BoolVar(&registriesUpdateNoAuth, "no-auth", false, "Disable authentication for the registry")
+}
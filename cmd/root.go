@@ -22,6 +22,7 @@ func Execute() {
 func init() {
 	rootCmd.PersistentFlags().String("server-url", "", "Portainer server URL")
 	rootCmd.PersistentFlags().StringP("output", "o", "table", "Output format (table|json|yaml)")
+	rootCmd.PersistentFlags().String("endpoint", "", "Environment name or ID (defaults to default-endpoint from config)")
 
 	viper.BindPFlag("server_url", rootCmd.PersistentFlags().Lookup("server-url"))
 
@@ -30,5 +31,30 @@ func init() {
 
 	rootCmd.AddCommand(configCmd)
 	rootCmd.AddCommand(authCmd)
+	rootCmd.AddCommand(serverCmd)
+	rootCmd.AddCommand(settingsCmd)
 	rootCmd.AddCommand(stacksCmd)
+	rootCmd.AddCommand(endpointsCmd)
+	rootCmd.AddCommand(endpointGroupsCmd)
+	rootCmd.AddCommand(edgeStacksCmd)
+	rootCmd.AddCommand(edgeGroupsCmd)
+	rootCmd.AddCommand(edgeJobsCmd)
+	rootCmd.AddCommand(tagsCmd)
+	rootCmd.AddCommand(k8sCmd)
+	rootCmd.AddCommand(helmCmd)
+	rootCmd.AddCommand(templatesCmd)
+	rootCmd.AddCommand(webhooksCmd)
+	rootCmd.AddCommand(backupCmd)
+	rootCmd.AddCommand(containersCmd)
+	rootCmd.AddCommand(servicesCmd)
+	rootCmd.AddCommand(imagesCmd)
+	rootCmd.AddCommand(volumesCmd)
+	rootCmd.AddCommand(networksCmd)
+	rootCmd.AddCommand(secretsCmd)
+	rootCmd.AddCommand(configsCmd)
+	rootCmd.AddCommand(nodesCmd)
+	rootCmd.AddCommand(registriesCmd)
+	rootCmd.AddCommand(usersCmd)
+	rootCmd.AddCommand(teamsCmd)
+	rootCmd.AddCommand(tokensCmd)
 }
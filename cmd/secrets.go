@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var secretsCmd = &cobra.Command{
+	Use:   "secrets",
+	Short: "Manage swarm secrets",
+	Long:  `Manage Docker Swarm secrets of a swarm environment through the Portainer Docker proxy`,
+}
+
+func init() {
+	secretsCmd.AddCommand(secretsListCmd)
+	secretsCmd.AddCommand(secretsInspectCmd)
+	secretsCmd.AddCommand(secretsCreateCmd)
+	secretsCmd.AddCommand(secretsRmCmd)
+	secretsCmd.AddCommand(secretsRotateCmd)
+}
+
+func readObjectData(cmd *cobra.Command, file string, value string) ([]byte, error) {
+	hasValue := cmd.Flags().Changed("value")
+	if file != "" && hasValue {
+		return nil, fmt.Errorf("--file and --value cannot be used together")
+	}
+
+	var data []byte
+	var err error
+	switch {
+	case hasValue:
+		data = []byte(value)
+	case file == "-":
+		data, err = io.ReadAll(cmd.InOrStdin())
+	case file != "":
+		data, err = os.ReadFile(file)
+	default:
+		return nil, fmt.Errorf("content not specified. Use --file (- for stdin) or --value")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read content: %w", err)
+	}
+
+	if len(data) == 0 {
+		return nil, fmt.Errorf("content is empty")
+	}
+
+	return data, nil
+}
+
+func secretUsers(services []types.Service, secretIDs map[string]bool) []types.Service {
+	var users []types.Service
+	for _, service := range services {
+		for _, reference := range service.Spec.TaskTemplate.ContainerSpec.Secrets {
+			if secretIDs[reference.SecretID] {
+				users = append(users, service)
+				break
+			}
+		}
+	}
+
+	return users
+}
+
+func configUsers(services []types.Service, configID string) []types.Service {
+	var users []types.Service
+	for _, service := range services {
+		for _, reference := range service.Spec.TaskTemplate.ContainerSpec.Configs {
+			if reference.ConfigID == configID {
+				users = append(users, service)
+				break
+			}
+		}
+	}
+
+	return users
+}
+
+func serviceNames(services []types.Service) []string {
+	names := make([]string, 0, len(services))
+	for _, service := range services {
+		names = append(names, service.Spec.Name)
+	}
+
+	return names
+}
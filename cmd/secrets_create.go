@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var (
+	secretsCreateFile   string
+	secretsCreateValue  string
+	secretsCreateLabels []string
+)
+
+var secretsCreateCmd = &cobra.Command{
+	Use:   "create [name]",
+	Short: "Create a secret",
+	Long: `Create a swarm secret from a file, stdin or a literal value.
+
+Prefer --file or stdin over --value: literal values end up in the shell history.
+
+Examples:
+  # Create a secret from a file
+  portainer secrets create tls_key --file ./server.key
+
+  # Create a secret from stdin
+  echo -n "$DB_PASSWORD" | portainer secrets create db_password --file -
+
+  # Create a labelled secret from a literal value
+  portainer secrets create api_token --value "$API_TOKEN" --label team=payments`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		data, err := readObjectData(cmd, secretsCreateFile, secretsCreateValue)
+		if err != nil {
+			return err
+		}
+
+		labels, err := parseKeyValueFlags(secretsCreateLabels, "label")
+		if err != nil {
+			return err
+		}
+
+		cl, cfg, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		endpointID, err := requireEndpointID(cmd, cl, cfg)
+		if err != nil {
+			return err
+		}
+
+		id, err := cl.CreateSecret(cmd.Context(), endpointID, types.SecretSpec{
+			Name:   args[0],
+			Labels: labels,
+			Data:   data,
+		})
+		if err != nil {
+			return apiError(err, "create secret", "endpoint")
+		}
+
+		fmt.Printf("Secret %s created successfully (ID: %s)\n", args[0], id)
+		return nil
+	},
+}
+
+func init() {
+	secretsCreateCmd.Flags().StringVar(&secretsCreateFile, "file", "", "Read the secret from a file (- for stdin)")
+	secretsCreateCmd.Flags().StringVar(&secretsCreateValue, "value", "", "Literal secret value")
+	secretsCreateCmd.Flags().StringArrayVar(&secretsCreateLabels, "label", []string{}, "Secret label (format: KEY=value, can be used multiple times)")
+}
@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"github.com/pdrhp/portainer-go-cli/internal/printer"
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var secretsInspectCmd = &cobra.Command{
+	Use:   "inspect [secret]",
+	Short: "Show details of a secret",
+	Long: `Show details of a secret referenced by ID or name, including the services using it.
+
+Examples:
+  # Inspect a secret
+  portainer secrets inspect db_password
+
+  # Output in JSON format
+  portainer secrets inspect db_password --output json`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, cfg, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		endpointID, err := requireEndpointID(cmd, cl, cfg)
+		if err != nil {
+			return err
+		}
+
+		secret, err := cl.InspectSecret(cmd.Context(), endpointID, args[0])
+		if err != nil {
+			return apiError(err, "inspect secret", "secret")
+		}
+
+		services, err := cl.ListServices(cmd.Context(), endpointID, nil)
+		if err != nil {
+			return apiError(err, "list services", "endpoint")
+		}
+
+		details := types.SecretDetails{
+			Secret: *secret,
+			UsedBy: serviceNames(secretUsers(services, map[string]bool{secret.ID: true})),
+		}
+
+		outputFormat := cmd.Flag("output").Value.String()
+
+		return printer.PrintSecret(details, outputFormat)
+	},
+}
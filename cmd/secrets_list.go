@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/internal/printer"
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var secretsListStack string
+
+var secretsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List secrets",
+	Long: `List secrets of a swarm environment. Secret values are never returned by Docker.
+
+Examples:
+  # List secrets of the default endpoint
+  portainer secrets list
+
+  # List secrets created by a stack
+  portainer secrets list --stack web
+
+  # Output in JSON format
+  portainer secrets list --output json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, cfg, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		endpointID, err := requireEndpointID(cmd, cl, cfg)
+		if err != nil {
+			return err
+		}
+
+		secrets, err := cl.ListSecrets(cmd.Context(), endpointID)
+		if err != nil {
+			return apiError(err, "list secrets", "endpoint")
+		}
+
+		if secretsListStack != "" {
+			filtered := make([]types.Secret, 0, len(secrets))
+			for _, secret := range secrets {
+				if secret.StackName() == secretsListStack {
+					filtered = append(filtered, secret)
+				}
+			}
+			secrets = filtered
+		}
+
+		if len(secrets) == 0 {
+			fmt.Println("No secrets found.")
+			return nil
+		}
+
+		outputFormat := cmd.Flag("output").Value.String()
+
+		return printer.PrintSecrets(secrets, outputFormat)
+	},
+}
+
+func init() {
+	secretsListCmd.Flags().StringVar(&secretsListStack, "stack", "", "Filter secrets by stack name")
+}
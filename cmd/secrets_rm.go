@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var secretsRmCmd = &cobra.Command{
+	Use:     "rm [secret...]",
+	Aliases: []string{"remove"},
+	Short:   "Remove one or more secrets",
+	Long: `Remove one or more secrets referenced by ID or name. Secrets used by a service cannot be removed.
+
+Examples:
+  # Remove secrets
+  portainer secrets rm db_password.v1 db_password.v2`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, cfg, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		endpointID, err := requireEndpointID(cmd, cl, cfg)
+		if err != nil {
+			return err
+		}
+
+		failed := 0
+		for _, secret := range args {
+			if err := cl.RemoveSecret(cmd.Context(), endpointID, secret); err != nil {
+				fmt.Printf("Error: %s: %v\n", secret, apiError(err, "remove secret", "secret"))
+				failed++
+				continue
+			}
+			fmt.Printf("Removed %s\n", secret)
+		}
+
+		if failed > 0 {
+			return fmt.Errorf("failed to remove %d of %d secrets", failed, len(args))
+		}
+
+		return nil
+	},
+}
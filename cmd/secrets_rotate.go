@@ -0,0 +1,190 @@
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pdrhp/portainer-go-cli/internal/client"
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var secretVersionPattern = regexp.MustCompile(`^(.+)\.v(\d+)$`)
+
+var (
+	secretsRotateFile      string
+	secretsRotateValue     string
+	secretsRotateRemoveOld bool
+	secretsRotateDryRun    bool
+	secretsRotateDetach    bool
+	secretsRotateTimeout   time.Duration
+)
+
+var secretsRotateCmd = &cobra.Command{
+	Use:   "rotate [secret]",
+	Short: "Create a new version of a secret and move services to it",
+	Long: `Create a new version of a secret and update every service referencing a
+previous version to use it.
+
+Swarm secrets are immutable, so versions are separate secrets named
+<name>.v2, <name>.v3... The unversioned name counts as version 1. Services keep
+the same target file, so applications read the new value from the same path
+after the rolling update.
+
+Stacks redeployed from their compose file reference the secret names written in
+it again; reference the versioned name there as well.
+
+Examples:
+  # Rotate a secret from stdin
+  echo -n "$NEW_PASSWORD" | portainer secrets rotate db_password --file -
+
+  # Preview the rotation
+  portainer secrets rotate db_password --file ./password.txt --dry-run
+
+  # Rotate and remove the previous versions once services converged
+  portainer secrets rotate db_password --file ./password.txt --remove-old`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if secretsRotateRemoveOld && secretsRotateDetach {
+			return fmt.Errorf("--remove-old cannot be used with --detach")
+		}
+
+		data, err := readObjectData(cmd, secretsRotateFile, secretsRotateValue)
+		if err != nil {
+			return err
+		}
+
+		cl, cfg, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		endpointID, err := requireEndpointID(cmd, cl, cfg)
+		if err != nil {
+			return err
+		}
+
+		secrets, err := cl.ListSecrets(cmd.Context(), endpointID)
+		if err != nil {
+			return apiError(err, "list secrets", "endpoint")
+		}
+
+		versions, nextName := secretVersions(secrets, args[0])
+		if len(versions) == 0 {
+			return fmt.Errorf("Secret %s not found", args[0])
+		}
+		current := versions[len(versions)-1]
+
+		oldIDs := make([]string, 0, len(versions))
+		oldIDSet := make(map[string]bool, len(versions))
+		for _, secret := range versions {
+			oldIDs = append(oldIDs, secret.ID)
+			oldIDSet[secret.ID] = true
+		}
+
+		services, err := cl.ListServices(cmd.Context(), endpointID, nil)
+		if err != nil {
+			return apiError(err, "list services", "endpoint")
+		}
+		users := secretUsers(services, oldIDSet)
+
+		if secretsRotateDryRun {
+			fmt.Printf("Would create secret %s (current: %s)\n", nextName, current.Spec.Name)
+			if len(users) == 0 {
+				fmt.Println("No services reference this secret.")
+			}
+			for _, service := range users {
+				fmt.Printf("Would update service %s\n", service.Spec.Name)
+			}
+			return nil
+		}
+
+		newID, err := cl.CreateSecret(cmd.Context(), endpointID, types.SecretSpec{
+			Name:   nextName,
+			Labels: current.Spec.Labels,
+			Data:   data,
+		})
+		if err != nil {
+			return apiError(err, "create secret", "endpoint")
+		}
+		fmt.Printf("Secret %s created (ID: %s)\n", nextName, newID)
+
+		mutator := client.ReplaceServiceSecret(oldIDs, newID, nextName)
+		for _, service := range users {
+			if err := cl.UpdateService(cmd.Context(), endpointID, service.ID, mutator); err != nil {
+				return apiError(err, "update service "+service.Spec.Name, "service")
+			}
+			fmt.Printf("Service %s updated\n", service.Spec.Name)
+		}
+
+		if secretsRotateDetach || len(users) == 0 {
+			return nil
+		}
+
+		if err := waitForServices(cmd.Context(), cl, endpointID, serviceNames(users), serviceUpdateMarks(users), secretsRotateTimeout); err != nil {
+			return err
+		}
+
+		if !secretsRotateRemoveOld {
+			return nil
+		}
+
+		for _, secret := range versions {
+			if err := cl.RemoveSecret(cmd.Context(), endpointID, secret.ID); err != nil {
+				fmt.Printf("Warning: could not remove %s: %v\n", secret.Spec.Name, apiError(err, "remove secret", "secret"))
+				continue
+			}
+			fmt.Printf("Removed %s\n", secret.Spec.Name)
+		}
+
+		return nil
+	},
+}
+
+func secretVersions(secrets []types.Secret, name string) ([]types.Secret, string) {
+	base := name
+	if match := secretVersionPattern.FindStringSubmatch(name); match != nil {
+		base = match[1]
+	}
+
+	byVersion := map[int]types.Secret{}
+	latest := 0
+	for _, secret := range secrets {
+		// The unversioned name is version 1
+		version := 0
+		if secret.Spec.Name == base {
+			version = 1
+		} else if strings.HasPrefix(secret.Spec.Name, base+".v") {
+			version, _ = strconv.Atoi(strings.TrimPrefix(secret.Spec.Name, base+".v"))
+		}
+		if version <= 0 {
+			continue
+		}
+
+		byVersion[version] = secret
+		if version > latest {
+			latest = version
+		}
+	}
+
+	versions := make([]types.Secret, 0, len(byVersion))
+	for version := 1; version <= latest; version++ {
+		if secret, ok := byVersion[version]; ok {
+			versions = append(versions, secret)
+		}
+	}
+
+	return versions, fmt.Sprintf("%s.v%d", base, latest+1)
+}
+
+func init() {
+	secretsRotateCmd.Flags().StringVar(&secretsRotateFile, "file", "", "Read the new secret from a file (- for stdin)")
+	secretsRotateCmd.Flags().StringVar(&secretsRotateValue, "value", "", "Literal new secret value")
+	secretsRotateCmd.Flags().BoolVar(&secretsRotateRemoveOld, "remove-old", false, "Remove the previous versions once the services converged")
+	secretsRotateCmd.Flags().BoolVar(&secretsRotateDryRun, "dry-run", false, "Show the new secret name and the services that would be updated")
+	secretsRotateCmd.Flags().BoolVarP(&secretsRotateDetach, "detach", "d", false, "Exit immediately instead of waiting for the services to converge")
+	secretsRotateCmd.Flags().DurationVar(&secretsRotateTimeout, "timeout", 10*time.Minute, "Maximum time to wait for the services to converge")
+}
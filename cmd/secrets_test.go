@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretVersions(t *testing.T) {
+	secrets := []types.Secret{
+		{ID: "s1", Spec: types.SecretSpec{Name: "db_password"}},
+		{ID: "s3", Spec: types.SecretSpec{Name: "db_password.v3"}},
+		{ID: "s2", Spec: types.SecretSpec{Name: "db_password.v2"}},
+		{ID: "x1", Spec: types.SecretSpec{Name: "db_password_old"}},
+		{ID: "x2", Spec: types.SecretSpec{Name: "db"}},
+	}
+
+	versions, next := secretVersions(secrets, "db_password")
+	require.Len(t, versions, 3)
+	assert.Equal(t, "s1", versions[0].ID)
+	assert.Equal(t, "s3", versions[2].ID)
+	assert.Equal(t, "db_password.v4", next)
+
+	versions, next = secretVersions(secrets, "db_password.v2")
+	assert.Len(t, versions, 3)
+	assert.Equal(t, "db_password.v4", next)
+
+	versions, next = secretVersions(secrets, "api_token")
+	assert.Empty(t, versions)
+	assert.Equal(t, "api_token.v1", next)
+}
+
+func TestReadObjectData(t *testing.T) {
+	newCmd := func() (*cobra.Command, *string, *string) {
+		var file, value string
+		cmd := &cobra.Command{}
+		cmd.Flags().StringVar(&file, "file", "", "")
+		cmd.Flags().StringVar(&value, "value", "", "")
+		return cmd, &file, &value
+	}
+
+	cmd, file, value := newCmd()
+	require.NoError(t, cmd.Flags().Set("value", "literal"))
+	data, err := readObjectData(cmd, *file, *value)
+	require.NoError(t, err)
+	assert.Equal(t, "literal", string(data))
+
+	cmd, file, value = newCmd()
+	cmd.SetIn(strings.NewReader("from-stdin"))
+	require.NoError(t, cmd.Flags().Set("file", "-"))
+	data, err = readObjectData(cmd, *file, *value)
+	require.NoError(t, err)
+	assert.Equal(t, "from-stdin", string(data))
+
+	path := filepath.Join(t.TempDir(), "secret.txt")
+	require.NoError(t, os.WriteFile(path, []byte("from-file"), 0600))
+	cmd, file, value = newCmd()
+	require.NoError(t, cmd.Flags().Set("file", path))
+	data, err = readObjectData(cmd, *file, *value)
+	require.NoError(t, err)
+	assert.Equal(t, "from-file", string(data))
+
+	cmd, file, value = newCmd()
+	_, err = readObjectData(cmd, *file, *value)
+	assert.Error(t, err)
+
+	cmd, file, value = newCmd()
+	require.NoError(t, cmd.Flags().Set("value", ""))
+	_, err = readObjectData(cmd, *file, *value)
+	assert.EqualError(t, err, "content is empty")
+}
@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// Range of Portainer versions whose API the commands of this CLI are written against
+const (
+	minServerVersion = "2.19.0"
+	maxServerVersion = "3.0.0"
+)
+
+var serverCmd = &cobra.Command{
+	Use:   "server",
+	Short: "Inspect the Portainer server",
+	Long:  `Inspect the Portainer server the CLI talks to`,
+}
+
+func init() {
+	serverCmd.AddCommand(serverStatusCmd)
+}
+
+func parseVersion(value string) ([3]int, error) {
+	var version [3]int
+
+	// Pre-release and build suffixes (2.21.0-rc1, 2.21.0+abc) are ignored
+	core := strings.TrimPrefix(strings.TrimSpace(value), "v")
+	if i := strings.IndexAny(core, "-+ "); i >= 0 {
+		core = core[:i]
+	}
+
+	parts := strings.Split(core, ".")
+	if core == "" || len(parts) > 3 {
+		return version, fmt.Errorf("invalid version %q", value)
+	}
+	for i, part := range parts {
+		number, err := strconv.Atoi(part)
+		if err != nil || number < 0 {
+			return version, fmt.Errorf("invalid version %q", value)
+		}
+		version[i] = number
+	}
+
+	return version, nil
+}
+
+func compareVersions(a, b string) (int, error) {
+	va, err := parseVersion(a)
+	if err != nil {
+		return 0, err
+	}
+	vb, err := parseVersion(b)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range va {
+		if va[i] != vb[i] {
+			if va[i] < vb[i] {
+				return -1, nil
+			}
+			return 1, nil
+		}
+	}
+	return 0, nil
+}
+
+func serverCompatibility(version string) (string, error) {
+	if c, err := compareVersions(version, minServerVersion); err != nil {
+		return "", err
+	} else if c < 0 {
+		return "too old", nil
+	}
+	if c, err := compareVersions(version, maxServerVersion); err != nil {
+		return "", err
+	} else if c >= 0 {
+		return "newer than supported", nil
+	}
+	return "supported", nil
+}
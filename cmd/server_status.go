@@ -0,0 +1,132 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/pdrhp/portainer-go-cli/internal/client"
+	"github.com/pdrhp/portainer-go-cli/internal/printer"
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var serverStatusRequireVersion string
+
+var serverStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show the version and health of the Portainer server",
+	Long: `Show the version, edition and instance ID of the Portainer server and check it
+against the range of Portainer versions supported by this CLI (` + minServerVersion + ` <= version < ` + maxServerVersion + `).
+
+The version and instance ID come from the public /api/status endpoint, so the
+command works without credentials. When authenticated, the edition, database
+version, platform and agent counts are added from /api/system/version and
+/api/system/info. Rejected credentials, such as an expired session, only leave
+these out with a note. Portainer does not report its uptime, the response time of
+/api/status is shown as a health indicator instead.
+
+The command fails when the server cannot be reached. With --require-version it
+also fails when the server is older than the given version, or than the oldest
+supported version when no value is given. A value must be
+attached with =, as in --require-version=2.21.0.
+
+Examples:
+  # Pipeline preflight
+  portainer server status --require-version
+
+  # Require a specific version
+  portainer server status --require-version=2.21.0
+
+  # As JSON
+  portainer server status -o json`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if serverStatusRequireVersion != "" {
+			if _, err := parseVersion(serverStatusRequireVersion); err != nil {
+				return fmt.Errorf("--require-version: %w", err)
+			}
+		}
+
+		cl, cfg, err := newPublicClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		started := time.Now()
+		systemStatus, err := cl.GetSystemStatus(cmd.Context())
+		if err != nil {
+			return apiError(err, "reach the Portainer server", "server status")
+		}
+
+		status := types.ServerStatus{
+			URL:            cl.BaseURL(),
+			Version:        systemStatus.Version,
+			InstanceID:     systemStatus.InstanceID,
+			ResponseTimeMS: time.Since(started).Milliseconds(),
+			SupportedRange: fmt.Sprintf(">= %s, < %s", minServerVersion, maxServerVersion),
+		}
+
+		if cfg.Token != "" || cfg.APIKey != "" {
+			if err := addAuthenticatedServerStatus(cmd.Context(), cl, &status); err != nil {
+				// An expired session must not fail the probe of a healthy server
+				if !isAuthError(err) {
+					return apiError(err, "get server details", "server details")
+				}
+				status.Note = "Credentials rejected, edition, platform and agents are not shown. Run 'portainer auth' again"
+			}
+		} else {
+			status.Note = "Not authenticated, edition, platform and agents are not shown"
+		}
+
+		status.Compatibility, err = serverCompatibility(status.Version)
+		if err != nil {
+			return fmt.Errorf("server reported an %w", err)
+		}
+
+		if err := printer.PrintServerStatus(status, cmd.Flag("output").Value.String()); err != nil {
+			return err
+		}
+
+		if serverStatusRequireVersion != "" {
+			if c, _ := compareVersions(status.Version, serverStatusRequireVersion); c < 0 {
+				return fmt.Errorf("Portainer %s is older than the required version %s", status.Version, serverStatusRequireVersion)
+			}
+		}
+
+		return nil
+	},
+}
+
+func addAuthenticatedServerStatus(ctx context.Context, cl *client.Client, status *types.ServerStatus) error {
+	version, err := cl.GetSystemVersion(ctx)
+	if err != nil {
+		return err
+	}
+
+	info, err := cl.GetSystemInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	status.Edition = version.ServerEdition
+	status.DatabaseVersion = version.DatabaseVersion
+	status.LatestVersion = version.LatestVersion
+	status.UpdateAvailable = version.UpdateAvailable
+	status.Platform = info.Platform
+	status.Agents = info.Agents
+	status.EdgeAgents = info.EdgeAgents
+
+	return nil
+}
+
+func isAuthError(err error) bool {
+	var httpErr *client.HTTPError
+	return errors.As(err, &httpErr) && (httpErr.StatusCode == 401 || httpErr.StatusCode == 403)
+}
+
+func init() {
+	serverStatusCmd.Flags().StringVar(&serverStatusRequireVersion, "require-version", "", "Fail when the server is older than this version (defaults to "+minServerVersion+" when given without value)")
+	serverStatusCmd.Flags().Lookup("require-version").NoOptDefVal = minServerVersion
+}
@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pdrhp/portainer-go-cli/internal/client"
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"2.21.0", "2.21.0", 0},
+		{"2.21", "2.21.0", 0},
+		{"v2.21.4", "2.21.0", 1},
+		{"2.9.0", "2.19.0", -1},
+		{"2.21.0-rc1", "2.21.0", 0},
+		{"3.0.0", "2.33.1", 1},
+	}
+
+	for _, tt := range tests {
+		got, err := compareVersions(tt.a, tt.b)
+		require.NoError(t, err, tt.a)
+		assert.Equal(t, tt.want, got, "%s vs %s", tt.a, tt.b)
+	}
+
+	_, err := compareVersions("latest", "2.21.0")
+	assert.Error(t, err)
+}
+
+func TestServerCompatibility(t *testing.T) {
+	compatibility, err := serverCompatibility("2.18.4")
+	require.NoError(t, err)
+	assert.Equal(t, "too old", compatibility)
+
+	compatibility, err = serverCompatibility(minServerVersion)
+	require.NoError(t, err)
+	assert.Equal(t, "supported", compatibility)
+
+	compatibility, err = serverCompatibility(maxServerVersion)
+	require.NoError(t, err)
+	assert.Equal(t, "newer than supported", compatibility)
+}
+
+func TestAddAuthenticatedServerStatus_ExpiredSession(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/system/version", r.URL.Path)
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	cl := client.New(server.URL)
+	cl.SetToken("expired-token")
+
+	status := types.ServerStatus{Version: "2.21.4"}
+	err := addAuthenticatedServerStatus(context.Background(), cl, &status)
+
+	assert.True(t, isAuthError(err))
+	assert.Empty(t, status.Edition)
+}
@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pdrhp/portainer-go-cli/internal/client"
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+const servicePollInterval = 2 * time.Second
+
+var servicesCmd = &cobra.Command{
+	Use:   "services",
+	Short: "Manage swarm services",
+	Long:  `Manage Docker Swarm services of a swarm environment through the Portainer Docker proxy`,
+}
+
+func init() {
+	servicesCmd.AddCommand(servicesListCmd)
+	servicesCmd.AddCommand(servicesInspectCmd)
+	servicesCmd.AddCommand(servicesScaleCmd)
+	servicesCmd.AddCommand(servicesUpdateCmd)
+}
+
+func waitForServices(ctx context.Context, cl *client.Client, endpointID int, serviceIDs []string, previousUpdates map[string]string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for _, serviceID := range serviceIDs {
+		fmt.Printf("Waiting for service %s to converge...\n", serviceID)
+		if err := cl.WaitForService(ctx, endpointID, serviceID, previousUpdates[serviceID], servicePollInterval); err != nil {
+			return err
+		}
+		fmt.Printf("Service %s converged\n", serviceID)
+	}
+
+	return nil
+}
+
+func serviceUpdateMarks(services []types.Service) map[string]string {
+	marks := make(map[string]string, len(services))
+	for _, service := range services {
+		marks[service.Spec.Name] = service.LastUpdateStarted()
+	}
+	return marks
+}
+
+func inspectServiceUpdateMarks(ctx context.Context, cl *client.Client, endpointID int, serviceIDs []string) (map[string]string, error) {
+	// Recorded before a change, so waitForServices can tell its rolling update from an earlier one
+	marks := make(map[string]string, len(serviceIDs))
+	for _, serviceID := range serviceIDs {
+		service, err := cl.InspectService(ctx, endpointID, serviceID)
+		if err != nil {
+			return nil, apiError(err, "inspect service "+serviceID, "service")
+		}
+		marks[serviceID] = service.LastUpdateStarted()
+	}
+	return marks, nil
+}
@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"github.com/pdrhp/portainer-go-cli/internal/printer"
+	"github.com/spf13/cobra"
+)
+
+var servicesInspectCmd = &cobra.Command{
+	Use:   "inspect [service]",
+	Short: "Show details of a service",
+	Long: `Show details of a service referenced by ID or name.
+
+Examples:
+  # Inspect a service
+  portainer services inspect my-stack_web
+
+  # Output in YAML format
+  portainer services inspect my-stack_web --output yaml`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, cfg, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		endpointID, err := requireEndpointID(cmd, cl, cfg)
+		if err != nil {
+			return err
+		}
+
+		service, err := cl.InspectService(cmd.Context(), endpointID, args[0])
+		if err != nil {
+			return apiError(err, "inspect service", "service")
+		}
+
+		outputFormat := cmd.Flag("output").Value.String()
+
+		return printer.PrintService(*service, outputFormat)
+	},
+}
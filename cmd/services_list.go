@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/internal/printer"
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var servicesListStack string
+
+var servicesListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List services",
+	Long: `List services of a swarm environment with their running/desired replicas.
+
+Examples:
+  # List all services of the default endpoint
+  portainer services list
+
+  # List services of a stack
+  portainer services list --endpoint prod-swarm --stack my-stack
+
+  # Output in JSON format
+  portainer services list --output json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, cfg, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		endpointID, err := requireEndpointID(cmd, cl, cfg)
+		if err != nil {
+			return err
+		}
+
+		var filters *types.ServiceFilters
+		if servicesListStack != "" {
+			filters = &types.ServiceFilters{Stack: servicesListStack}
+		}
+
+		services, err := cl.ListServices(cmd.Context(), endpointID, filters)
+		if err != nil {
+			return apiError(err, "list services", "endpoint")
+		}
+
+		if len(services) == 0 {
+			fmt.Println("No services found.")
+			return nil
+		}
+
+		outputFormat := cmd.Flag("output").Value.String()
+
+		return printer.PrintServices(services, outputFormat)
+	},
+}
+
+func init() {
+	servicesListCmd.Flags().StringVar(&servicesListStack, "stack", "", "Filter services by stack name")
+}
@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pdrhp/portainer-go-cli/internal/client"
+	"github.com/spf13/cobra"
+)
+
+var (
+	servicesScaleDetach  bool
+	servicesScaleTimeout time.Duration
+)
+
+type serviceScale struct {
+	Service  string
+	Replicas uint64
+}
+
+var servicesScaleCmd = &cobra.Command{
+	Use:   "scale [service=replicas...]",
+	Short: "Scale one or more replicated services",
+	Long: `Scale one or more replicated services and wait until the new replica count is running.
+
+Examples:
+  # Scale a service to 3 replicas
+  portainer services scale my-stack_web=3
+
+  # Scale several services without waiting
+  portainer services scale my-stack_web=3 my-stack_worker=0 --detach`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		scales, err := parseServiceScales(args)
+		if err != nil {
+			return err
+		}
+
+		cl, cfg, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		endpointID, err := requireEndpointID(cmd, cl, cfg)
+		if err != nil {
+			return err
+		}
+
+		services := make([]string, 0, len(scales))
+		for _, scale := range scales {
+			services = append(services, scale.Service)
+		}
+
+		// Scaling does not start a rolling update, the status left by an earlier
+		// deploy stays the same and is not taken into account
+		previousUpdates, err := inspectServiceUpdateMarks(cmd.Context(), cl, endpointID, services)
+		if err != nil {
+			return err
+		}
+
+		scaled := make([]string, 0, len(scales))
+		for _, scale := range scales {
+			if err := cl.UpdateService(cmd.Context(), endpointID, scale.Service, client.SetServiceReplicas(scale.Replicas)); err != nil {
+				return apiError(err, "scale service "+scale.Service, "service")
+			}
+			fmt.Printf("Service %s scaled to %d\n", scale.Service, scale.Replicas)
+			scaled = append(scaled, scale.Service)
+		}
+
+		if servicesScaleDetach {
+			return nil
+		}
+
+		return waitForServices(cmd.Context(), cl, endpointID, scaled, previousUpdates, servicesScaleTimeout)
+	},
+}
+
+func parseServiceScales(args []string) ([]serviceScale, error) {
+	scales := make([]serviceScale, 0, len(args))
+	for _, arg := range args {
+		service, value, found := strings.Cut(arg, "=")
+		if !found || service == "" {
+			return nil, fmt.Errorf("invalid scale argument %q (format: service=replicas)", arg)
+		}
+
+		replicas, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid replica count in %q", arg)
+		}
+
+		scales = append(scales, serviceScale{Service: service, Replicas: replicas})
+	}
+
+	return scales, nil
+}
+
+func init() {
+	servicesScaleCmd.Flags().BoolVarP(&servicesScaleDetach, "detach", "d", false, "Exit immediately instead of waiting for the services to converge")
+	servicesScaleCmd.Flags().DurationVar(&servicesScaleTimeout, "timeout", 5*time.Minute, "Maximum time to wait for the services to converge")
+}
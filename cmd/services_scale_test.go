@@ -0,0 +1,26 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseServiceScales_Valid(t *testing.T) {
+	scales, err := parseServiceScales([]string{"shop_web=3", "shop_worker=0"})
+
+	require.NoError(t, err)
+	require.Len(t, scales, 2)
+	assert.Equal(t, serviceScale{Service: "shop_web", Replicas: 3}, scales[0])
+	assert.Equal(t, serviceScale{Service: "shop_worker", Replicas: 0}, scales[1])
+}
+
+func TestParseServiceScales_Invalid(t *testing.T) {
+	tests := []string{"shop_web", "=3", "shop_web=-1", "shop_web=three"}
+
+	for _, arg := range tests {
+		_, err := parseServiceScales([]string{arg})
+		assert.Error(t, err, arg)
+	}
+}
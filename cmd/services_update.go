@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pdrhp/portainer-go-cli/internal/client"
+	"github.com/spf13/cobra"
+)
+
+var (
+	servicesUpdateForce   bool
+	servicesUpdateImage   string
+	servicesUpdateDetach  bool
+	servicesUpdateTimeout time.Duration
+)
+
+var servicesUpdateCmd = &cobra.Command{
+	Use:   "update [service...]",
+	Short: "Force a rolling restart or change the image of services",
+	Long: `Update one or more services and wait for the rolling update to finish.
+
+The update uses the service version index for optimistic concurrency and is
+retried when the service was modified concurrently.
+
+Examples:
+  # Force a rolling restart
+  portainer services update my-stack_web --force
+
+  # Deploy a new image
+  portainer services update my-stack_web --image registry.example.com/web:1.4.2
+
+  # Restart several services without waiting
+  portainer services update my-stack_web my-stack_worker --force --detach`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if !servicesUpdateForce && servicesUpdateImage == "" {
+			return fmt.Errorf("nothing to update. Use --force and/or --image")
+		}
+
+		var mutators []client.ServiceSpecMutator
+		if servicesUpdateImage != "" {
+			mutators = append(mutators, client.SetServiceImage(servicesUpdateImage))
+		}
+		if servicesUpdateForce {
+			mutators = append(mutators, client.ForceServiceUpdate())
+		}
+
+		cl, cfg, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		endpointID, err := requireEndpointID(cmd, cl, cfg)
+		if err != nil {
+			return err
+		}
+
+		previousUpdates, err := inspectServiceUpdateMarks(cmd.Context(), cl, endpointID, args)
+		if err != nil {
+			return err
+		}
+
+		for _, service := range args {
+			if err := cl.UpdateService(cmd.Context(), endpointID, service, mutators...); err != nil {
+				return apiError(err, "update service "+service, "service")
+			}
+			fmt.Printf("Service %s updated\n", service)
+		}
+
+		if servicesUpdateDetach {
+			return nil
+		}
+
+		return waitForServices(cmd.Context(), cl, endpointID, args, previousUpdates, servicesUpdateTimeout)
+	},
+}
+
+func init() {
+	servicesUpdateCmd.Flags().BoolVar(&servicesUpdateForce, "force", false, "Force a rolling restart even if nothing changed")
+	servicesUpdateCmd.Flags().StringVar(&servicesUpdateImage, "image", "", "New image reference for the service")
+	servicesUpdateCmd.Flags().BoolVarP(&servicesUpdateDetach, "detach", "d", false, "Exit immediately instead of waiting for the rollout to finish")
+	servicesUpdateCmd.Flags().DurationVar(&servicesUpdateTimeout, "timeout", 10*time.Minute, "Maximum time to wait for the rollout to finish")
+}
@@ -0,0 +1,208 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var settingsCmd = &cobra.Command{
+	Use:   "settings",
+	Short: "Read and change the global Portainer settings",
+	Long:  `Read and change the global Portainer settings (admin only)`,
+}
+
+func init() {
+	settingsCmd.AddCommand(settingsGetCmd)
+	settingsCmd.AddCommand(settingsSetCmd)
+}
+
+func settingKey(object map[string]interface{}, name string) (string, bool) {
+	if _, ok := object[name]; ok {
+		return name, true
+	}
+	// Keys are PascalCase in the API, snapshotinterval is accepted as well
+	for key := range object {
+		if strings.EqualFold(key, name) {
+			return key, true
+		}
+	}
+	return "", false
+}
+
+func lookupSetting(settings types.Settings, path string) (interface{}, string, error) {
+	var current interface{} = map[string]interface{}(settings)
+	resolved := make([]string, 0)
+
+	for _, name := range strings.Split(path, ".") {
+		object, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, "", fmt.Errorf("setting %q is not an object", strings.Join(resolved, "."))
+		}
+		key, ok := settingKey(object, name)
+		if !ok {
+			return nil, "", fmt.Errorf("unknown setting %q", path)
+		}
+		resolved = append(resolved, key)
+		current = object[key]
+	}
+
+	return current, strings.Join(resolved, "."), nil
+}
+
+func setSetting(settings types.Settings, path string, raw string) error {
+	names := strings.Split(path, ".")
+	object := map[string]interface{}(settings)
+
+	for i, name := range names {
+		key, ok := settingKey(object, name)
+		if !ok {
+			return fmt.Errorf("unknown setting %q", path)
+		}
+
+		if i == len(names)-1 {
+			value, err := parseSettingValue(object[key], raw)
+			if err != nil {
+				return fmt.Errorf("%s: %w", path, err)
+			}
+			object[key] = value
+			return nil
+		}
+
+		next, ok := object[key].(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("setting %q is not an object", strings.Join(names[:i+1], "."))
+		}
+		object = next
+	}
+
+	return nil
+}
+
+func parseSettingValue(current interface{}, raw string) (interface{}, error) {
+	// The new value takes the type of the current one, so the document keeps its shape
+	switch current.(type) {
+	case string:
+		return raw, nil
+	case bool:
+		value, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, fmt.Errorf("expects true or false, got %q", raw)
+		}
+		return value, nil
+	case float64:
+		value, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return nil, fmt.Errorf("expects a number, got %q", raw)
+		}
+		return value, nil
+	case map[string]interface{}:
+		var value map[string]interface{}
+		if err := json.Unmarshal([]byte(raw), &value); err != nil {
+			return nil, fmt.Errorf("expects a JSON object: %w", err)
+		}
+		return value, nil
+	case []interface{}:
+		var value []interface{}
+		if err := json.Unmarshal([]byte(raw), &value); err != nil {
+			return nil, fmt.Errorf("expects a JSON array: %w", err)
+		}
+		return value, nil
+	default:
+		var value interface{}
+		if err := json.Unmarshal([]byte(raw), &value); err != nil {
+			return raw, nil
+		}
+		return value, nil
+	}
+}
+
+func flattenSettings(prefix string, value interface{}, out map[string]interface{}) {
+	object, ok := value.(map[string]interface{})
+	if !ok || len(object) == 0 {
+		if prefix != "" {
+			out[prefix] = value
+		}
+		return
+	}
+
+	for key, child := range object {
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+		flattenSettings(path, child, out)
+	}
+}
+
+func settingValues(prefix string, value interface{}) []types.SettingValue {
+	flat := make(map[string]interface{})
+	flattenSettings(prefix, value, flat)
+
+	values := make([]types.SettingValue, 0, len(flat))
+	for key, value := range flat {
+		values = append(values, types.SettingValue{Key: key, Value: value})
+	}
+	sort.Slice(values, func(i, j int) bool {
+		return values[i].Key < values[j].Key
+	})
+
+	return values
+}
+
+func diffSettings(before, after map[string]interface{}) []types.SettingChange {
+	keys := make(map[string]bool)
+	for key := range before {
+		keys[key] = true
+	}
+	for key := range after {
+		keys[key] = true
+	}
+
+	changes := make([]types.SettingChange, 0)
+	for key := range keys {
+		old, hadOld := before[key]
+		value, hasNew := after[key]
+		if hadOld && hasNew && formatSettingValue(old) == formatSettingValue(value) {
+			continue
+		}
+		changes = append(changes, types.SettingChange{Key: key, Old: old, New: value})
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Key < changes[j].Key
+	})
+
+	return changes
+}
+
+func unappliedSettings(changes []types.SettingChange, applied map[string]interface{}) []string {
+	ignored := make([]string, 0)
+	for _, change := range changes {
+		// Portainer never returns secrets, so they cannot be checked
+		if isSecretSetting(change.Key) {
+			continue
+		}
+		if formatSettingValue(applied[change.Key]) != formatSettingValue(change.New) {
+			ignored = append(ignored, change.Key)
+		}
+	}
+	return ignored
+}
+
+func isSecretSetting(key string) bool {
+	name := strings.ToLower(key[strings.LastIndex(key, ".")+1:])
+	return strings.Contains(name, "password") || strings.Contains(name, "secret")
+}
+
+func formatSettingValue(value interface{}) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(data)
+}
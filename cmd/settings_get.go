@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/internal/printer"
+	"github.com/spf13/cobra"
+)
+
+var settingsGetCmd = &cobra.Command{
+	Use:   "get [key]",
+	Short: "Show the Portainer settings",
+	Long: `Show the global Portainer settings, or a single one selected by a dotted key path.
+Keys are matched case-insensitively. A single value is printed as is, which
+makes it easy to use in scripts.
+
+Examples:
+  # Show all settings
+  portainer settings get
+
+  # Show one value
+  portainer settings get SnapshotInterval
+
+  # Show a nested group of settings
+  portainer settings get Edge
+
+  # As JSON
+  portainer settings get OAuthSettings -o json`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, _, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		settings, err := cl.GetSettings(cmd.Context())
+		if err != nil {
+			return apiError(err, "get settings", "settings")
+		}
+
+		var value interface{} = map[string]interface{}(settings)
+		key := ""
+		if len(args) == 1 {
+			value, key, err = lookupSetting(settings, args[0])
+			if err != nil {
+				return err
+			}
+		}
+
+		outputFormat := cmd.Flag("output").Value.String()
+		if _, ok := value.(map[string]interface{}); !ok && key != "" && outputFormat != "json" && outputFormat != "yaml" {
+			if s, ok := value.(string); ok {
+				fmt.Println(s)
+			} else {
+				fmt.Println(formatSettingValue(value))
+			}
+			return nil
+		}
+
+		return printer.PrintSettings(value, settingValues(key, value), outputFormat)
+	},
+}
@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var settingsSetDryRun bool
+
+var settingsSetCmd = &cobra.Command{
+	Use:   "set <key=value>...",
+	Short: "Change Portainer settings",
+	Long: `Change one or more global Portainer settings. Keys are dotted paths as shown by
+'portainer settings get' and must already exist. A value takes the type of the
+current one: true/false for booleans, numbers for numbers, JSON for objects and
+arrays, plain text for everything else.
+
+The current settings are fetched, patched and the changes are shown before the
+whole document is sent back. Nothing is sent when no value changes. Portainer
+silently ignores settings its API does not accept, so the command fails and
+lists every changed setting the server did not apply.
+
+Examples:
+  # Take a snapshot of the environments every 10 minutes
+  portainer settings set SnapshotInterval=10m
+
+  # Use a custom app templates URL and review the change first
+  portainer settings set TemplatesURL=https://example.com/templates.json --dry-run
+
+  # Change several settings at once
+  portainer settings set EnableEdgeComputeFeatures=true EnableTelemetry=false`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		for _, arg := range args {
+			if key, _, ok := strings.Cut(arg, "="); !ok || strings.TrimSpace(key) == "" {
+				return fmt.Errorf("invalid argument %q, expected key=value", arg)
+			}
+		}
+
+		cl, _, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		settings, err := cl.GetSettings(cmd.Context())
+		if err != nil {
+			return apiError(err, "get settings", "settings")
+		}
+
+		before := make(map[string]interface{})
+		flattenSettings("", map[string]interface{}(settings), before)
+
+		for _, arg := range args {
+			key, value, _ := strings.Cut(arg, "=")
+			if err := setSetting(settings, strings.TrimSpace(key), value); err != nil {
+				return err
+			}
+		}
+
+		after := make(map[string]interface{})
+		flattenSettings("", map[string]interface{}(settings), after)
+
+		changes := diffSettings(before, after)
+		if len(changes) == 0 {
+			fmt.Println("No changes")
+			return nil
+		}
+
+		for _, change := range changes {
+			fmt.Printf("~ %s: %s -> %s\n", change.Key, formatSettingValue(change.Old), formatSettingValue(change.New))
+		}
+
+		if settingsSetDryRun {
+			fmt.Printf("\nWould update %d settings\n", len(changes))
+			return nil
+		}
+
+		updated, err := cl.UpdateSettings(cmd.Context(), settings)
+		if err != nil {
+			return apiError(err, "update settings", "settings")
+		}
+		if updated == nil {
+			updated, err = cl.GetSettings(cmd.Context())
+			if err != nil {
+				return apiError(err, "get settings", "settings")
+			}
+		}
+
+		applied := make(map[string]interface{})
+		flattenSettings("", map[string]interface{}(updated), applied)
+
+		ignored := unappliedSettings(changes, applied)
+		if len(ignored) > 0 {
+			return fmt.Errorf("Portainer did not apply %d of %d settings, the settings API does not accept: %s",
+				len(ignored), len(changes), strings.Join(ignored, ", "))
+		}
+
+		fmt.Printf("\nUpdated %d settings\n", len(changes))
+		return nil
+	},
+}
+
+func init() {
+	settingsSetCmd.Flags().BoolVar(&settingsSetDryRun, "dry-run", false, "Show the changes without applying them")
+}
@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testSettings(t *testing.T) types.Settings {
+	var settings types.Settings
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"AuthenticationMethod": 1,
+		"SnapshotInterval": "5m",
+		"TemplatesURL": "",
+		"EnableEdgeComputeFeatures": false,
+		"BlackListedLabels": [],
+		"Edge": {"PingInterval": 60, "AsyncMode": false}
+	}`), &settings))
+	return settings
+}
+
+func TestLookupSetting(t *testing.T) {
+	settings := testSettings(t)
+
+	value, key, err := lookupSetting(settings, "edge.pinginterval")
+	require.NoError(t, err)
+	assert.Equal(t, "Edge.PingInterval", key)
+	assert.Equal(t, float64(60), value)
+
+	_, _, err = lookupSetting(settings, "Edge.Missing")
+	assert.ErrorContains(t, err, "unknown setting")
+
+	_, _, err = lookupSetting(settings, "SnapshotInterval.Value")
+	assert.ErrorContains(t, err, "not an object")
+}
+
+func TestSetSettingKeepsTypes(t *testing.T) {
+	settings := testSettings(t)
+
+	require.NoError(t, setSetting(settings, "SnapshotInterval", "10m"))
+	require.NoError(t, setSetting(settings, "EnableEdgeComputeFeatures", "true"))
+	require.NoError(t, setSetting(settings, "Edge.PingInterval", "30"))
+	require.NoError(t, setSetting(settings, "BlackListedLabels", `[{"name":"internal","value":"true"}]`))
+
+	assert.Equal(t, "10m", settings["SnapshotInterval"])
+	assert.Equal(t, true, settings["EnableEdgeComputeFeatures"])
+	assert.Equal(t, float64(30), settings["Edge"].(map[string]interface{})["PingInterval"])
+	assert.Len(t, settings["BlackListedLabels"], 1)
+
+	assert.ErrorContains(t, setSetting(settings, "EnableEdgeComputeFeatures", "yes please"), "true or false")
+	assert.ErrorContains(t, setSetting(settings, "AuthenticationMethod", "ldap"), "expects a number")
+	assert.ErrorContains(t, setSetting(settings, "TemplateURL", "x"), "unknown setting")
+}
+
+func TestDiffSettings(t *testing.T) {
+	settings := testSettings(t)
+
+	before := make(map[string]interface{})
+	flattenSettings("", map[string]interface{}(settings), before)
+
+	require.NoError(t, setSetting(settings, "SnapshotInterval", "5m"))
+	require.NoError(t, setSetting(settings, "Edge.PingInterval", "30"))
+
+	after := make(map[string]interface{})
+	flattenSettings("", map[string]interface{}(settings), after)
+
+	changes := diffSettings(before, after)
+	require.Len(t, changes, 1)
+	assert.Equal(t, "Edge.PingInterval", changes[0].Key)
+	assert.Equal(t, float64(60), changes[0].Old)
+	assert.Equal(t, float64(30), changes[0].New)
+}
+
+func TestUnappliedSettings(t *testing.T) {
+	changes := []types.SettingChange{
+		{Key: "SnapshotInterval", Old: "5m", New: "10m"},
+		{Key: "Edge.PingInterval", Old: float64(60), New: float64(30)},
+		{Key: "LDAPSettings.Password", Old: "", New: "secret"},
+	}
+	applied := map[string]interface{}{
+		"SnapshotInterval":      "10m",
+		"Edge.PingInterval":     float64(60),
+		"LDAPSettings.Password": "",
+	}
+
+	assert.Equal(t, []string{"Edge.PingInterval"}, unappliedSettings(changes, applied))
+}
@@ -1,6 +1,15 @@
 package cmd
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/pdrhp/portainer-go-cli/internal/client"
+	"github.com/pdrhp/portainer-go-cli/internal/config"
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
 	"github.com/spf13/cobra"
 )
 
@@ -14,4 +23,134 @@ func init() {
 	stacksCmd.AddCommand(stacksListCmd)
 	stacksCmd.AddCommand(stacksCreateSwarmGitCmd)
 	stacksCmd.AddCommand(stacksRedeployGitCmd)
+	stacksCmd.AddCommand(stacksAccessCmd)
+	stacksCmd.AddCommand(stacksCreateK8sGitCmd)
+	stacksCmd.AddCommand(stacksCreateK8sFileCmd)
+	stacksCmd.AddCommand(stacksCreateFromTemplateCmd)
+	stacksCmd.AddCommand(stacksWebhookTriggerCmd)
+}
+
+func stackCreateError(err error) error {
+	var httpErr *client.HTTPError
+	if errors.As(err, &httpErr) && httpErr.StatusCode == 409 {
+		return fmt.Errorf("Stack name or webhook ID already exists")
+	}
+	return apiError(err, "create stack", "endpoint")
+}
+
+func lookupStack(cmd *cobra.Command, cl *client.Client, cfg *config.Config, ref string) (*types.Stack, error) {
+	if id, err := strconv.Atoi(ref); err == nil {
+		stack, err := cl.GetStack(cmd.Context(), id)
+		if err != nil {
+			return nil, apiError(err, "get stack", "stack")
+		}
+		return stack, nil
+	}
+
+	endpointID, err := resolveEndpointID(cmd, cl, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	var filters *types.StackFilters
+	if endpointID > 0 {
+		filters = &types.StackFilters{EndpointID: endpointID}
+	}
+
+	stacks, err := cl.ListStacks(cmd.Context(), filters)
+	if err != nil {
+		return nil, apiError(err, "list stacks", "endpoint")
+	}
+
+	var match *types.Stack
+	for i := range stacks {
+		if stacks[i].Name != ref {
+			continue
+		}
+		if match != nil {
+			return nil, fmt.Errorf("several stacks are named %q, use --endpoint or the stack ID", ref)
+		}
+		match = &stacks[i]
+	}
+	if match == nil {
+		return nil, fmt.Errorf("stack %q not found", ref)
+	}
+
+	return match, nil
+}
+
+func stackServiceUpdateMarks(ctx context.Context, cl *client.Client, stack *types.Stack) (map[string]string, error) {
+	if stack.Type != types.StackTypeDockerSwarm {
+		return nil, nil
+	}
+
+	services, err := cl.ListServices(ctx, stack.EndpointID, &types.ServiceFilters{Stack: stack.Name})
+	if err != nil {
+		return nil, apiError(err, "list services", "stack")
+	}
+	return serviceUpdateMarks(services), nil
+}
+
+func waitForStack(ctx context.Context, cl *client.Client, stack *types.Stack, previousUpdates map[string]string, timeout time.Duration) error {
+	if stack.Type == types.StackTypeDockerSwarm {
+		services, err := cl.ListServices(ctx, stack.EndpointID, &types.ServiceFilters{Stack: stack.Name})
+		if err != nil {
+			return apiError(err, "list services", "stack")
+		}
+		return waitForServices(ctx, cl, stack.EndpointID, serviceNames(services), previousUpdates, timeout)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	fmt.Printf("Waiting for stack %s to converge...\n", stack.Name)
+	for {
+		converged, err := stackConverged(ctx, cl, stack)
+		if err != nil {
+			return err
+		}
+		if converged {
+			fmt.Printf("Stack %s converged\n", stack.Name)
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for stack %s to converge: %w", stack.Name, ctx.Err())
+		case <-time.After(servicePollInterval):
+		}
+	}
+}
+
+func stackConverged(ctx context.Context, cl *client.Client, stack *types.Stack) (bool, error) {
+	switch stack.Type {
+	case types.StackTypeKubernetes:
+		apps, err := listKubernetesApplications(ctx, cl, stack.EndpointID, stack.Namespace)
+		if err != nil {
+			return false, apiError(err, "list applications", "stack")
+		}
+		found := false
+		for _, app := range apps {
+			if app.Stack != stack.Name {
+				continue
+			}
+			found = true
+			if !app.RolledOut() {
+				return false, nil
+			}
+		}
+		return found, nil
+	default:
+		containers, err := cl.ListContainers(ctx, stack.EndpointID, &types.ContainerFilters{All: true, Stack: stack.Name})
+		if err != nil {
+			return false, apiError(err, "list containers", "stack")
+		}
+		for _, container := range containers {
+			// One-shot and init containers are done once they exited cleanly
+			if container.State != "running" && !container.ExitedSuccessfully() {
+				return false, nil
+			}
+		}
+		return len(containers) > 0, nil
+	}
 }
@@ -0,0 +1,34 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStackAccess_ResolvesNames(t *testing.T) {
+	stack := &types.Stack{
+		ID:   42,
+		Name: "shop",
+		ResourceControl: &types.ResourceControl{
+			ID:           9,
+			UserAccesses: []types.UserAccess{{UserID: 3}, {UserID: 8}},
+			TeamAccesses: []types.TeamAccess{{TeamID: 4}},
+		},
+	}
+	directory := &accessDirectory{
+		users: []types.User{{ID: 3, Username: "alice"}},
+		teams: []types.Team{{ID: 4, Name: "backend"}},
+	}
+
+	access := stackAccess(stack, directory)
+
+	assert.Equal(t, "restricted", access.Ownership)
+	assert.Equal(t, 9, access.ResourceControlID)
+	assert.Equal(t, []string{"alice", "8"}, access.Users)
+	assert.Equal(t, []string{"backend"}, access.Teams)
+
+	access = stackAccess(stack, nil)
+	assert.Equal(t, []string{"3", "8"}, access.Users)
+}
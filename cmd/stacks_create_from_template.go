@@ -0,0 +1,139 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pdrhp/portainer-go-cli/internal/envvars"
+	"github.com/pdrhp/portainer-go-cli/internal/wizard"
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var (
+	createFromTemplateName     string
+	createFromTemplateVars     []string
+	createFromTemplateDefaults bool
+	createFromTemplateEnv      []string
+	createFromTemplateSwarmID  string
+)
+
+var stacksCreateFromTemplateCmd = &cobra.Command{
+	Use:   "create-from-template [template]",
+	Short: "Create a stack from a custom template",
+	Long: `Create a swarm or compose stack from a custom template referenced by ID or title.
+
+The {{ VARIABLE }} placeholders declared by the template are replaced before
+deploying. Values come from --var; when no --var is given the variables are
+prompted interactively, unless --defaults is set.
+
+Examples:
+  # Deploy a template, prompting for its variables
+  portainer stacks create-from-template "Web API" --name orders-api --endpoint prod-swarm
+
+  # Deploy a template non-interactively
+  portainer stacks create-from-template "Web API" --name orders-api --var SERVICE_NAME=orders --var REPLICAS=3
+
+  # Deploy a template with its default values
+  portainer stacks create-from-template 12 --name cache --defaults --env REDIS_PASSWORD=secret`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if createFromTemplateName == "" {
+			return fmt.Errorf("--name is required")
+		}
+
+		provided, err := parseKeyValueFlags(createFromTemplateVars, "var")
+		if err != nil {
+			return err
+		}
+
+		var env []types.Pair
+		if len(createFromTemplateEnv) > 0 {
+			env, err = envvars.Parse(strings.Join(createFromTemplateEnv, "\n"))
+			if err != nil {
+				return fmt.Errorf("invalid --env: %w", err)
+			}
+		}
+
+		cl, cfg, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		template, err := lookupCustomTemplate(cmd.Context(), cl, args[0])
+		if err != nil {
+			return err
+		}
+
+		if template.Type != types.StackTypeDockerSwarm && template.Type != types.StackTypeDockerCompose {
+			return fmt.Errorf("template '%s' is a %s template, only swarm and compose templates can be deployed", template.Title, template.Type)
+		}
+
+		endpointID, err := requireEndpointID(cmd, cl, cfg)
+		if err != nil {
+			return err
+		}
+
+		if len(template.Variables) > 0 && len(provided) == 0 && !createFromTemplateDefaults {
+			provided, err = wizard.RunTemplateVariablesWizard(template.Title, template.Variables)
+			if err != nil {
+				return fmt.Errorf("wizard failed: %w", err)
+			}
+		}
+
+		values, err := resolveTemplateVariables(template.Variables, provided)
+		if err != nil {
+			return err
+		}
+
+		content, err := cl.GetCustomTemplateFile(cmd.Context(), template.ID)
+		if err != nil {
+			return apiError(err, "get custom template file", "custom template")
+		}
+		content = renderTemplateVariables(content, values)
+
+		fmt.Printf("Creating %s stack '%s' from template '%s'...\n", template.Type, createFromTemplateName, template.Title)
+
+		var stack *types.Stack
+		if template.Type == types.StackTypeDockerSwarm {
+			swarmID := createFromTemplateSwarmID
+			if swarmID == "" {
+				swarmID, err = cl.GetSwarmID(cmd.Context(), endpointID)
+				if err != nil {
+					return fmt.Errorf("failed to detect swarm ID for endpoint %d (use --swarm-id): %w", endpointID, err)
+				}
+			}
+
+			stack, err = cl.CreateSwarmStackFromString(cmd.Context(), endpointID, types.StackCreateSwarmStringPayload{
+				Name:             createFromTemplateName,
+				SwarmID:          swarmID,
+				StackFileContent: content,
+				Env:              env,
+			})
+		} else {
+			stack, err = cl.CreateComposeStackFromString(cmd.Context(), endpointID, types.StackCreateComposeStringPayload{
+				Name:             createFromTemplateName,
+				StackFileContent: content,
+				Env:              env,
+			})
+		}
+		if err != nil {
+			return stackCreateError(err)
+		}
+
+		fmt.Printf("Stack '%s' created successfully with ID: %d\n", stack.Name, stack.ID)
+		if template.Note != "" {
+			fmt.Printf("Note: %s\n", template.Note)
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	stacksCreateFromTemplateCmd.Flags().StringVar(&createFromTemplateName, "name", "", "Name of the stack (required)")
+	stacksCreateFromTemplateCmd.Flags().StringArrayVar(&createFromTemplateVars, "var", nil, "Template variable in NAME=VALUE format (can be used multiple times)")
+	stacksCreateFromTemplateCmd.Flags().BoolVar(&createFromTemplateDefaults, "defaults", false, "Use default values for variables not set with --var instead of prompting")
+	stacksCreateFromTemplateCmd.Flags().StringArrayVar(&createFromTemplateEnv, "env", nil, "Environment variables (format: KEY=value)")
+	stacksCreateFromTemplateCmd.Flags().StringVar(&createFromTemplateSwarmID, "swarm-id", "", "Swarm cluster identifier (detected from the endpoint when omitted)")
+}
@@ -0,0 +1,138 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var (
+	createK8sGitName                     string
+	createK8sGitNamespace                string
+	createK8sGitEndpointID               int
+	createK8sGitRepositoryURL            string
+	createK8sGitRepositoryReferenceName  string
+	createK8sGitManifestFile             string
+	createK8sGitAdditionalFiles          []string
+	createK8sGitComposeFormat            bool
+	createK8sGitTLSSkipVerify            bool
+	createK8sGitRepositoryUsername       string
+	createK8sGitRepositoryPassword       string
+	createK8sGitAutoUpdateInterval       string
+	createK8sGitAutoUpdateWebhook        string
+	createK8sGitAutoUpdateForcePullImage bool
+	createK8sGitAutoUpdateForceUpdate    bool
+)
+
+var stacksCreateK8sGitCmd = &cobra.Command{
+	Use:   "create-k8s-git",
+	Short: "Create a Kubernetes stack from a git repository",
+	Long: `Create a Kubernetes stack by applying manifests from a Git repository to a
+namespace of a Kubernetes environment.
+
+Examples:
+  # Create a stack from a manifest
+  portainer stacks create-k8s-git --name shop --namespace shop --repository-url https://github.com/acme/shop --manifest-file k8s/deployment.yml --endpoint prod-k8s
+
+  # Several manifests
+  portainer stacks create-k8s-git --name shop --namespace shop --repository-url https://github.com/acme/shop --manifest-file k8s/deployment.yml --additional-files k8s/service.yml,k8s/ingress.yml --endpoint prod-k8s
+
+  # Deploy a compose file, converted by Portainer
+  portainer stacks create-k8s-git --name shop --namespace shop --repository-url https://github.com/acme/shop --manifest-file docker-compose.yml --compose-format --endpoint prod-k8s
+
+  # With auto-update (GitOps)
+  portainer stacks create-k8s-git --name shop --namespace shop --repository-url https://github.com/acme/shop --manifest-file k8s/deployment.yml --auto-update-interval 5m --endpoint prod-k8s`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		payload, err := buildK8sGitPayloadFromFlags()
+		if err != nil {
+			return err
+		}
+
+		cl, cfg, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		endpointID := createK8sGitEndpointID
+		if endpointID == 0 {
+			endpointID, err = requireEndpointID(cmd, cl, cfg)
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := resolveAutoUpdateWebhook(payload.AutoUpdate); err != nil {
+			return err
+		}
+
+		fmt.Printf("Creating kubernetes stack '%s' from git repository...\n", payload.StackName)
+
+		stack, err := cl.CreateKubernetesStackFromGit(cmd.Context(), endpointID, payload)
+		if err != nil {
+			return stackCreateError(err)
+		}
+
+		fmt.Printf("Stack '%s' created successfully with ID: %d\n", stack.Name, stack.ID)
+		printStackWebhookURL(cl, payload.AutoUpdate)
+		return nil
+	},
+}
+
+func buildK8sGitPayloadFromFlags() (types.StackCreateKubernetesGitPayload, error) {
+	if createK8sGitName == "" {
+		return types.StackCreateKubernetesGitPayload{}, fmt.Errorf("--name is required")
+	}
+	if createK8sGitRepositoryURL == "" {
+		return types.StackCreateKubernetesGitPayload{}, fmt.Errorf("--repository-url is required")
+	}
+	if createK8sGitManifestFile == "" {
+		return types.StackCreateKubernetesGitPayload{}, fmt.Errorf("--manifest-file is required")
+	}
+	if (createK8sGitRepositoryUsername == "") != (createK8sGitRepositoryPassword == "") {
+		return types.StackCreateKubernetesGitPayload{}, fmt.Errorf("--repository-username and --repository-password must be provided together")
+	}
+
+	payload := types.StackCreateKubernetesGitPayload{
+		StackName:                createK8sGitName,
+		Namespace:                createK8sGitNamespace,
+		ComposeFormat:            createK8sGitComposeFormat,
+		RepositoryURL:            createK8sGitRepositoryURL,
+		RepositoryReferenceName:  createK8sGitRepositoryReferenceName,
+		RepositoryAuthentication: createK8sGitRepositoryUsername != "",
+		RepositoryUsername:       createK8sGitRepositoryUsername,
+		RepositoryPassword:       createK8sGitRepositoryPassword,
+		ManifestFile:             createK8sGitManifestFile,
+		AdditionalFiles:          createK8sGitAdditionalFiles,
+		TLSSkipVerify:            createK8sGitTLSSkipVerify,
+	}
+
+	if createK8sGitAutoUpdateInterval != "" || createK8sGitAutoUpdateWebhook != "" {
+		payload.AutoUpdate = &types.AutoUpdateSettings{
+			Interval:       createK8sGitAutoUpdateInterval,
+			Webhook:        createK8sGitAutoUpdateWebhook,
+			ForcePullImage: createK8sGitAutoUpdateForcePullImage,
+			ForceUpdate:    createK8sGitAutoUpdateForceUpdate,
+		}
+	}
+
+	return payload, nil
+}
+
+func init() {
+	stacksCreateK8sGitCmd.Flags().StringVar(&createK8sGitName, "name", "", "Name of the stack (required)")
+	stacksCreateK8sGitCmd.Flags().StringVar(&createK8sGitNamespace, "namespace", "default", "Namespace to deploy to")
+	stacksCreateK8sGitCmd.Flags().IntVar(&createK8sGitEndpointID, "endpoint-id", 0, "Identifier of the environment (alternative to --endpoint)")
+	stacksCreateK8sGitCmd.Flags().StringVar(&createK8sGitRepositoryURL, "repository-url", "", "URL of the Git repository (required)")
+	stacksCreateK8sGitCmd.Flags().StringVar(&createK8sGitRepositoryReferenceName, "repository-reference-name", "refs/heads/master", "Git reference (branch/tag)")
+	stacksCreateK8sGitCmd.Flags().StringVar(&createK8sGitManifestFile, "manifest-file", "", "Path to the manifest in the repository (required)")
+	stacksCreateK8sGitCmd.Flags().StringSliceVar(&createK8sGitAdditionalFiles, "additional-files", []string{}, "Additional manifests in the repository")
+	stacksCreateK8sGitCmd.Flags().BoolVar(&createK8sGitComposeFormat, "compose-format", false, "The manifests are compose files to convert")
+	stacksCreateK8sGitCmd.Flags().BoolVar(&createK8sGitTLSSkipVerify, "tlsskip-verify", false, "Skip TLS verification for Git repository")
+	stacksCreateK8sGitCmd.Flags().StringVar(&createK8sGitRepositoryUsername, "repository-username", "", "Username for Git repository authentication")
+	stacksCreateK8sGitCmd.Flags().StringVar(&createK8sGitRepositoryPassword, "repository-password", "", "Password for Git repository authentication")
+	stacksCreateK8sGitCmd.Flags().StringVar(&createK8sGitAutoUpdateInterval, "auto-update-interval", "", "Auto-update interval (e.g., 1h, 30m)")
+	stacksCreateK8sGitCmd.Flags().StringVar(&createK8sGitAutoUpdateWebhook, "auto-update-webhook", "", "Webhook ID for auto-update (auto to generate one)")
+	stacksCreateK8sGitCmd.Flags().BoolVar(&createK8sGitAutoUpdateForcePullImage, "auto-update-force-pull-image", false, "Force pull latest image on auto-update")
+	stacksCreateK8sGitCmd.Flags().BoolVar(&createK8sGitAutoUpdateForceUpdate, "auto-update-force-update", false, "Force update even without repository changes")
+}
@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func resetK8sGitFlags() {
+	createK8sGitName = "shop"
+	createK8sGitNamespace = "shop"
+	createK8sGitRepositoryURL = "https://github.com/acme/shop"
+	createK8sGitRepositoryReferenceName = "refs/heads/main"
+	createK8sGitManifestFile = "k8s/deployment.yml"
+	createK8sGitAdditionalFiles = []string{}
+	createK8sGitComposeFormat = false
+	createK8sGitTLSSkipVerify = false
+	createK8sGitRepositoryUsername = ""
+	createK8sGitRepositoryPassword = ""
+	createK8sGitAutoUpdateInterval = ""
+	createK8sGitAutoUpdateWebhook = ""
+	createK8sGitAutoUpdateForcePullImage = false
+	createK8sGitAutoUpdateForceUpdate = false
+}
+
+func TestBuildK8sGitPayloadFromFlags_BasicFields(t *testing.T) {
+	resetK8sGitFlags()
+	createK8sGitAdditionalFiles = []string{"k8s/service.yml"}
+
+	payload, err := buildK8sGitPayloadFromFlags()
+	require.NoError(t, err)
+
+	assert.Equal(t, "shop", payload.StackName)
+	assert.Equal(t, "shop", payload.Namespace)
+	assert.Equal(t, "k8s/deployment.yml", payload.ManifestFile)
+	assert.Equal(t, []string{"k8s/service.yml"}, payload.AdditionalFiles)
+	assert.False(t, payload.RepositoryAuthentication)
+	assert.Nil(t, payload.AutoUpdate)
+}
+
+func TestBuildK8sGitPayloadFromFlags_WithAutoUpdate(t *testing.T) {
+	resetK8sGitFlags()
+	createK8sGitAutoUpdateInterval = "5m"
+	createK8sGitAutoUpdateForcePullImage = true
+
+	payload, err := buildK8sGitPayloadFromFlags()
+	require.NoError(t, err)
+
+	require.NotNil(t, payload.AutoUpdate)
+	assert.Equal(t, "5m", payload.AutoUpdate.Interval)
+	assert.True(t, payload.AutoUpdate.ForcePullImage)
+}
+
+func TestBuildK8sGitPayloadFromFlags_RequiresManifestFile(t *testing.T) {
+	resetK8sGitFlags()
+	createK8sGitManifestFile = ""
+
+	_, err := buildK8sGitPayloadFromFlags()
+	assert.ErrorContains(t, err, "--manifest-file")
+}
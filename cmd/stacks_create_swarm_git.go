@@ -6,7 +6,6 @@ import (
 	"strings"
 
 	"github.com/pdrhp/portainer-go-cli/internal/client"
-	"github.com/pdrhp/portainer-go-cli/internal/config"
 	"github.com/pdrhp/portainer-go-cli/internal/envvars"
 	"github.com/pdrhp/portainer-go-cli/internal/wizard"
 	"github.com/pdrhp/portainer-go-cli/pkg/types"
@@ -40,36 +39,33 @@ Examples:
   # Create stack with all flags
   portainer stacks create-swarm-git --name myStack --repository-url https://github.com/user/repo --swarm-id jpofkc0i9uo9wtx1zesuk649w --endpoint-id 1 --compose-file docker-compose.yml --repository-reference-name refs/heads/main --env KEY1=value1 --env KEY2=value2
 
+  # Create stack detecting the Swarm ID from the endpoint
+  portainer stacks create-swarm-git --name myStack --repository-url https://github.com/user/repo --endpoint-id 1
+
+  # Create stack on an endpoint referenced by name (or on default-endpoint from config)
+  portainer stacks create-swarm-git --name myStack --repository-url https://github.com/user/repo --endpoint prod-swarm
+
   # Create stack with Git authentication
   portainer stacks create-swarm-git --name myStack --repository-url https://github.com/user/repo --swarm-id jpofkc0i9uo9wtx1zesuk649w --endpoint-id 1 --repository-username user --repository-password pass
 
   # Create stack with auto-update (GitOps)
   portainer stacks create-swarm-git --name myStack --repository-url https://github.com/user/repo --swarm-id jpofkc0i9uo9wtx1zesuk649w --endpoint-id 1 --auto-update-interval 1h --auto-update-webhook abc123
 
+  # Create stack with a generated webhook, its trigger URL is printed
+  portainer stacks create-swarm-git --name myStack --repository-url https://github.com/user/repo --endpoint prod-swarm --auto-update-webhook auto
+
   # Interactive creation
   portainer stacks create-swarm-git`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		cfg, err := config.Load()
+		cl, cfg, err := newAPIClient(cmd)
 		if err != nil {
-			return fmt.Errorf("failed to load config: %w", err)
-		}
-
-		if cfg.Token == "" {
-			return fmt.Errorf("not authenticated. Please run 'portainer auth' first")
-		}
-
-		serverURL := cmd.Flag("server-url").Value.String()
-		if serverURL == "" {
-			serverURL = cfg.ServerURL
-		}
-		if serverURL == "" {
-			return fmt.Errorf("server URL not configured. Use --server-url flag or set it in config")
+			return err
 		}
 
 		var payload types.StackCreateSwarmGitPayload
 		var endpointID int
 
-		useWizard := createSwarmGitName == "" && createSwarmGitRepositoryURL == "" && createSwarmGitSwarmID == "" && createSwarmGitEndpointID == 0
+		useWizard := createSwarmGitName == "" && createSwarmGitRepositoryURL == "" && createSwarmGitSwarmID == "" && createSwarmGitEndpointID == 0 && !cmd.Flags().Changed("endpoint")
 
 		if useWizard {
 			wizardPayload, endpointIDFromWizard, err := wizard.RunCreateSwarmGitWizard()
@@ -85,22 +81,33 @@ Examples:
 			if createSwarmGitRepositoryURL == "" {
 				return fmt.Errorf("flag --repository-url is required when not using wizard")
 			}
-			if createSwarmGitSwarmID == "" {
-				return fmt.Errorf("flag --swarm-id is required when not using wizard")
-			}
-			if createSwarmGitEndpointID == 0 {
-				return fmt.Errorf("flag --endpoint-id is required when not using wizard")
-			}
 
 			payload, err = buildPayloadFromFlags()
 			if err != nil {
 				return fmt.Errorf("invalid input flags: %w", err)
 			}
+
 			endpointID = createSwarmGitEndpointID
+			if endpointID == 0 {
+				endpointID, err = requireEndpointID(cmd, cl, cfg)
+				if err != nil {
+					return err
+				}
+			}
 		}
 
-		cl := client.New(serverURL)
-		cl.SetToken(cfg.Token)
+		if err := resolveAutoUpdateWebhook(payload.AutoUpdate); err != nil {
+			return err
+		}
+
+		if payload.SwarmID == "" {
+			swarmID, err := cl.GetSwarmID(cmd.Context(), endpointID)
+			if err != nil {
+				return fmt.Errorf("failed to detect swarm ID for endpoint %d (use --swarm-id): %w", endpointID, err)
+			}
+			payload.SwarmID = swarmID
+			fmt.Printf("Using swarm ID %s from endpoint %d\n", swarmID, endpointID)
+		}
 
 		fmt.Printf("Creating swarm stack '%s' from git repository...\n", payload.Name)
 
@@ -123,6 +130,7 @@ Examples:
 		}
 
 		fmt.Printf("Stack '%s' created successfully with ID: %d\n", stack.Name, stack.ID)
+		printStackWebhookURL(cl, payload.AutoUpdate)
 		return nil
 	},
 }
@@ -181,8 +189,8 @@ func buildPayloadFromFlags() (types.StackCreateSwarmGitPayload, error) {
 func init() {
 	stacksCreateSwarmGitCmd.Flags().StringVar(&createSwarmGitName, "name", "", "Name of the stack (required)")
 	stacksCreateSwarmGitCmd.Flags().StringVar(&createSwarmGitRepositoryURL, "repository-url", "", "URL of the Git repository (required)")
-	stacksCreateSwarmGitCmd.Flags().StringVar(&createSwarmGitSwarmID, "swarm-id", "", "Swarm cluster identifier (required)")
-	stacksCreateSwarmGitCmd.Flags().IntVar(&createSwarmGitEndpointID, "endpoint-id", 0, "Identifier of the environment (required)")
+	stacksCreateSwarmGitCmd.Flags().StringVar(&createSwarmGitSwarmID, "swarm-id", "", "Swarm cluster identifier (detected from --endpoint-id when omitted)")
+	stacksCreateSwarmGitCmd.Flags().IntVar(&createSwarmGitEndpointID, "endpoint-id", 0, "Identifier of the environment (alternative to --endpoint)")
 	stacksCreateSwarmGitCmd.Flags().StringVar(&createSwarmGitComposeFile, "compose-file", "docker-compose.yml", "Path to the compose file in the repository")
 	stacksCreateSwarmGitCmd.Flags().StringVar(&createSwarmGitRepositoryReferenceName, "repository-reference-name", "refs/heads/master", "Git reference (branch/tag)")
 	stacksCreateSwarmGitCmd.Flags().BoolVar(&createSwarmGitTLSSkipVerify, "tlsskip-verify", false, "Skip TLS verification for Git repository")
@@ -191,7 +199,7 @@ func init() {
 	stacksCreateSwarmGitCmd.Flags().StringArrayVar(&createSwarmGitEnv, "env", []string{}, "Environment variables (format: KEY=value)")
 	stacksCreateSwarmGitCmd.Flags().StringSliceVar(&createSwarmGitAdditionalFiles, "additional-files", []string{}, "Additional compose files")
 	stacksCreateSwarmGitCmd.Flags().StringVar(&createSwarmGitAutoUpdateInterval, "auto-update-interval", "", "Auto-update interval (e.g., 1h, 30m)")
-	stacksCreateSwarmGitCmd.Flags().StringVar(&createSwarmGitAutoUpdateWebhook, "auto-update-webhook", "", "Webhook ID for auto-update")
+	stacksCreateSwarmGitCmd.Flags().StringVar(&createSwarmGitAutoUpdateWebhook, "auto-update-webhook", "", "Webhook ID for auto-update (auto to generate one)")
 	stacksCreateSwarmGitCmd.Flags().BoolVar(&createSwarmGitAutoUpdateForcePullImage, "auto-update-force-pull-image", false, "Force pull latest image on auto-update")
 	stacksCreateSwarmGitCmd.Flags().BoolVar(&createSwarmGitAutoUpdateForceUpdate, "auto-update-force-update", false, "Force update even without repository changes")
 }
@@ -1,67 +1,70 @@
 package cmd
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
 	"github.com/pdrhp/portainer-go-cli/internal/client"
-	"github.com/pdrhp/portainer-go-cli/internal/config"
 	"github.com/pdrhp/portainer-go-cli/internal/printer"
 	"github.com/pdrhp/portainer-go-cli/pkg/types"
 	"github.com/spf13/cobra"
 )
 
 var (
-	endpointID int
-	swarmID    string
+	endpointID      int
+	swarmID         string
+	stacksListTag   string
+	stacksListGroup string
 )
 
 var stacksListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List stacks",
-	Long: `List all stacks available in the Portainer environment.
+	Long: `List all stacks available in the Portainer environment. Without --endpoint-id
+or --endpoint the stacks of the default-endpoint from the config are listed.
 	
 Examples:
-  # List all stacks
+  # List all stacks, or those of the default-endpoint when one is configured
   portainer stacks list
   
   # Filter by endpoint
   portainer stacks list --endpoint-id 1
+
+  # Filter by endpoint name
+  portainer stacks list --endpoint prod-swarm
   
   # Filter by Swarm cluster
   portainer stacks list --swarm-id jpofkc0i9uo9wtx1zesuk649w
   
+  # Filter by environment tag or group
+  portainer stacks list --tag prod
+  portainer stacks list --group edge-sites
+  
   # Output in JSON format
   portainer stacks list --output json`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		cfg, err := config.Load()
+		cl, cfg, err := newAPIClient(cmd)
 		if err != nil {
-			return fmt.Errorf("failed to load config: %w", err)
+			return err
 		}
 
-		if cfg.Token == "" {
-			return fmt.Errorf("not authenticated. Please run 'portainer auth' first")
-		}
-
-		serverURL := cmd.Flag("server-url").Value.String()
-		if serverURL == "" {
-			serverURL = cfg.ServerURL
-		}
-		if serverURL == "" {
-			return fmt.Errorf("server URL not configured. Use --server-url flag or set it in config")
+		filterEndpointID := endpointID
+		if filterEndpointID == 0 {
+			filterEndpointID, err = resolveEndpointID(cmd, cl, cfg)
+			if err != nil {
+				return err
+			}
 		}
 
 		var filters *types.StackFilters
-		if endpointID > 0 || swarmID != "" {
+		if filterEndpointID > 0 || swarmID != "" {
 			filters = &types.StackFilters{
-				EndpointID: endpointID,
+				EndpointID: filterEndpointID,
 				SwarmID:    swarmID,
 			}
 		}
 
-		cl := client.New(serverURL)
-		cl.SetToken(cfg.Token)
-
 		stacks, err := cl.ListStacks(cmd.Context(), filters)
 		if err != nil {
 			var httpErr *client.HTTPError
@@ -78,6 +81,13 @@ Examples:
 			return fmt.Errorf("Failed to list stacks: %w", err)
 		}
 
+		if stacksListTag != "" || stacksListGroup != "" {
+			stacks, err = filterStacksByEndpoint(cmd.Context(), cl, stacks, stacksListTag, stacksListGroup)
+			if err != nil {
+				return err
+			}
+		}
+
 		if len(stacks) == 0 {
 			fmt.Println("No stacks found.")
 			return nil
@@ -92,4 +102,32 @@ Examples:
 func init() {
 	stacksListCmd.Flags().IntVar(&endpointID, "endpoint-id", 0, "Filter stacks by endpoint ID")
 	stacksListCmd.Flags().StringVar(&swarmID, "swarm-id", "", "Filter stacks by Swarm cluster ID")
+	stacksListCmd.Flags().StringVar(&stacksListTag, "tag", "", "Filter stacks by environment tag (name or ID)")
+	stacksListCmd.Flags().StringVar(&stacksListGroup, "group", "", "Filter stacks by environment group (name or ID)")
+}
+
+func filterStacksByEndpoint(ctx context.Context, cl *client.Client, stacks []types.Stack, tag, group string) ([]types.Stack, error) {
+	endpoints, err := cl.ListEndpoints(ctx, nil)
+	if err != nil {
+		return nil, apiError(err, "list endpoints", "endpoint")
+	}
+
+	endpoints, err = filterEndpoints(ctx, cl, endpoints, tag, group)
+	if err != nil {
+		return nil, err
+	}
+
+	matching := make(map[int]bool, len(endpoints))
+	for _, endpoint := range endpoints {
+		matching[endpoint.ID] = true
+	}
+
+	var filtered []types.Stack
+	for _, stack := range stacks {
+		if matching[stack.EndpointID] {
+			filtered = append(filtered, stack)
+		}
+	}
+
+	return filtered, nil
 }
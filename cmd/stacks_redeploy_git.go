@@ -5,9 +5,9 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pdrhp/portainer-go-cli/internal/client"
-	"github.com/pdrhp/portainer-go-cli/internal/config"
 	"github.com/pdrhp/portainer-go-cli/internal/envvars"
 	"github.com/pdrhp/portainer-go-cli/internal/wizard"
 	"github.com/pdrhp/portainer-go-cli/pkg/types"
@@ -24,6 +24,8 @@ var (
 	redeployGitPrune                   bool
 	redeployGitPullImage               bool
 	redeployGitStackName               string
+	redeployGitWait                    bool
+	redeployGitTimeout                 time.Duration
 )
 
 var stacksRedeployGitCmd = &cobra.Command{
@@ -35,27 +37,21 @@ Examples:
   # Redeploy with flags
   portainer stacks redeploy 123 --endpoint-id 1 --env KEY1=value1 --prune --pull-image
 
+  # Redeploy on an endpoint referenced by name (or on default-endpoint from config)
+  portainer stacks redeploy 123 --endpoint prod-swarm --pull-image
+
   # Redeploy with Git authentication
   portainer stacks redeploy 123 --endpoint-id 1 --repository-username user --repository-password pass
 
+  # Redeploy and wait until the stack has converged
+  portainer stacks redeploy 123 --endpoint prod-swarm --pull-image --wait --timeout 5m
+
   # Interactive redeploy
   portainer stacks redeploy`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		cfg, err := config.Load()
+		cl, cfg, err := newAPIClient(cmd)
 		if err != nil {
-			return fmt.Errorf("failed to load config: %w", err)
-		}
-
-		if cfg.Token == "" {
-			return fmt.Errorf("not authenticated. Please run 'portainer auth' first")
-		}
-
-		serverURL := cmd.Flag("server-url").Value.String()
-		if serverURL == "" {
-			serverURL = cfg.ServerURL
-		}
-		if serverURL == "" {
-			return fmt.Errorf("server URL not configured. Use --server-url flag or set it in config")
+			return err
 		}
 
 		var payload types.StackGitRedeployPayload
@@ -72,7 +68,7 @@ Examples:
 			}
 		} else if redeployGitStackID > 0 {
 			stackID = redeployGitStackID
-		} else if hasNonInteractiveRedeployInput() {
+		} else if hasNonInteractiveRedeployInput() || cmd.Flags().Changed("endpoint") {
 			return fmt.Errorf("stack ID is required when using redeploy flags (use positional [stack-id] or --stack-id)")
 		} else {
 			// Use wizard
@@ -86,10 +82,13 @@ Examples:
 		}
 
 		if endpointID == 0 {
-			if redeployGitEndpointID == 0 {
-				return fmt.Errorf("--endpoint-id is required")
-			}
 			endpointID = redeployGitEndpointID
+			if endpointID == 0 {
+				endpointID, err = requireEndpointID(cmd, cl, cfg)
+				if err != nil {
+					return err
+				}
+			}
 
 			payload, err = buildRedeployPayloadFromFlags()
 			if err != nil {
@@ -97,11 +96,20 @@ Examples:
 			}
 		}
 
-		cl := client.New(serverURL)
-		cl.SetToken(cfg.Token)
-
 		fmt.Printf("Redeploying stack %d from Git repository...\n", stackID)
 
+		var previousUpdates map[string]string
+		if redeployGitWait {
+			current, err := cl.GetStack(cmd.Context(), stackID)
+			if err != nil {
+				return apiError(err, "get stack", "stack")
+			}
+			previousUpdates, err = stackServiceUpdateMarks(cmd.Context(), cl, current)
+			if err != nil {
+				return err
+			}
+		}
+
 		stack, err := cl.RedeployStackFromGit(cmd.Context(), stackID, endpointID, payload)
 		if err != nil {
 			var httpErr *client.HTTPError
@@ -123,7 +131,12 @@ Examples:
 		}
 
 		fmt.Printf("Stack '%s' redeployed successfully\n", stack.Name)
-		return nil
+
+		if !redeployGitWait {
+			return nil
+		}
+
+		return waitForStack(cmd.Context(), cl, stack, previousUpdates, redeployGitTimeout)
 	},
 }
 
@@ -171,7 +184,7 @@ func buildRedeployPayloadFromFlags() (types.StackGitRedeployPayload, error) {
 
 func init() {
 	stacksRedeployGitCmd.Flags().IntVar(&redeployGitStackID, "stack-id", 0, "Stack ID to redeploy (alternative to positional argument)")
-	stacksRedeployGitCmd.Flags().IntVar(&redeployGitEndpointID, "endpoint-id", 0, "Environment identifier (required)")
+	stacksRedeployGitCmd.Flags().IntVar(&redeployGitEndpointID, "endpoint-id", 0, "Environment identifier (alternative to --endpoint)")
 	stacksRedeployGitCmd.Flags().StringVar(&redeployGitRepositoryReferenceName, "repository-reference-name", "", "Git reference (branch/tag)")
 	stacksRedeployGitCmd.Flags().StringVar(&redeployGitRepositoryUsername, "repository-username", "", "Username for Git repository authentication")
 	stacksRedeployGitCmd.Flags().StringVar(&redeployGitRepositoryPassword, "repository-password", "", "Password for Git repository authentication")
@@ -179,5 +192,6 @@ func init() {
 	stacksRedeployGitCmd.Flags().BoolVar(&redeployGitPrune, "prune", false, "Remove services that are no longer referenced")
 	stacksRedeployGitCmd.Flags().BoolVar(&redeployGitPullImage, "pull-image", false, "Force pull the latest image")
 	stacksRedeployGitCmd.Flags().StringVar(&redeployGitStackName, "stack-name", "", "Stack name (Kubernetes only)")
-
+	stacksRedeployGitCmd.Flags().BoolVar(&redeployGitWait, "wait", false, "Wait until the services, containers or applications of the stack have converged")
+	stacksRedeployGitCmd.Flags().DurationVar(&redeployGitTimeout, "timeout", 10*time.Minute, "Maximum time to wait with --wait")
 }
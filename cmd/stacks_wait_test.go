@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pdrhp/portainer-go-cli/internal/client"
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStackConverged_Compose(t *testing.T) {
+	containers := `[{"Id":"a","State":"running","Labels":{"com.docker.compose.project":"shop"}},{"Id":"b","State":"restarting","Labels":{"com.docker.compose.project":"shop"}},{"Id":"c","State":"exited","Labels":{"com.docker.compose.project":"blog"}}]`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/endpoints/2/docker/containers/json", r.URL.Path)
+		w.Write([]byte(containers))
+	}))
+	defer server.Close()
+
+	cl := client.New(server.URL)
+	cl.SetToken("test-token")
+
+	converged, err := stackConverged(context.Background(), cl, &types.Stack{Name: "shop", Type: types.StackTypeDockerCompose, EndpointID: 2})
+	require.NoError(t, err)
+	assert.False(t, converged)
+
+	containers = `[{"Id":"a","State":"running","Labels":{"com.docker.compose.project":"shop"}},{"Id":"c","State":"exited","Labels":{"com.docker.compose.project":"blog"}}]`
+	converged, err = stackConverged(context.Background(), cl, &types.Stack{Name: "shop", Type: types.StackTypeDockerCompose, EndpointID: 2})
+	require.NoError(t, err)
+	assert.True(t, converged)
+
+	// A migration container that exited cleanly does not block the wait, a failed one does
+	containers = `[{"Id":"a","State":"running","Labels":{"com.docker.compose.project":"shop"}},{"Id":"m","State":"exited","Status":"Exited (0) 3 seconds ago","Labels":{"com.docker.compose.project":"shop"}}]`
+	converged, err = stackConverged(context.Background(), cl, &types.Stack{Name: "shop", Type: types.StackTypeDockerCompose, EndpointID: 2})
+	require.NoError(t, err)
+	assert.True(t, converged)
+
+	containers = `[{"Id":"a","State":"running","Labels":{"com.docker.compose.project":"shop"}},{"Id":"m","State":"exited","Status":"Exited (1) 3 seconds ago","Labels":{"com.docker.compose.project":"shop"}}]`
+	converged, err = stackConverged(context.Background(), cl, &types.Stack{Name: "shop", Type: types.StackTypeDockerCompose, EndpointID: 2})
+	require.NoError(t, err)
+	assert.False(t, converged)
+}
+
+func TestStackConverged_Kubernetes(t *testing.T) {
+	// Mid-rollout: the old pods are still ready, only one pod runs the new spec
+	api := `{"metadata":{"name":"api","namespace":"shop","generation":5,"labels":{"io.portainer.kubernetes.application.stack":"shop"}},"spec":{"replicas":2},"status":{"observedGeneration":5,"replicas":3,"readyReplicas":2,"updatedReplicas":1}}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/endpoints/3/kubernetes/apis/apps/v1/namespaces/shop/deployments":
+			w.Write([]byte(`{"items":[` + api + `,
+				{"metadata":{"name":"legacy","namespace":"shop","generation":1},"spec":{"replicas":1},"status":{"observedGeneration":1,"readyReplicas":0}}
+			]}`))
+		case "/api/endpoints/3/kubernetes/apis/apps/v1/namespaces/shop/statefulsets":
+			w.Write([]byte(`{"items":[]}`))
+		case "/api/endpoints/3/kubernetes/apis/apps/v1/namespaces/shop/daemonsets":
+			w.Write([]byte(`{"items":[{"metadata":{"name":"agent","namespace":"shop","generation":2,"labels":{"io.portainer.kubernetes.application.stack":"shop"}},"status":{"observedGeneration":2,"currentNumberScheduled":3,"desiredNumberScheduled":3,"numberReady":3,"updatedNumberScheduled":3}}]}`))
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	cl := client.New(server.URL)
+	cl.SetToken("test-token")
+	stack := &types.Stack{Name: "shop", Type: types.StackTypeKubernetes, EndpointID: 3, Namespace: "shop"}
+
+	converged, err := stackConverged(context.Background(), cl, stack)
+	require.NoError(t, err)
+	assert.False(t, converged)
+
+	// Right after the apply the controller has not seen the new spec yet
+	api = `{"metadata":{"name":"api","namespace":"shop","generation":6,"labels":{"io.portainer.kubernetes.application.stack":"shop"}},"spec":{"replicas":2},"status":{"observedGeneration":5,"replicas":2,"readyReplicas":2,"updatedReplicas":2}}`
+	converged, err = stackConverged(context.Background(), cl, stack)
+	require.NoError(t, err)
+	assert.False(t, converged)
+
+	api = `{"metadata":{"name":"api","namespace":"shop","generation":6,"labels":{"io.portainer.kubernetes.application.stack":"shop"}},"spec":{"replicas":2},"status":{"observedGeneration":6,"replicas":2,"readyReplicas":2,"updatedReplicas":2}}`
+	converged, err = stackConverged(context.Background(), cl, stack)
+	require.NoError(t, err)
+	assert.True(t, converged)
+}
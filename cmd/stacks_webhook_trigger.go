@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pdrhp/portainer-go-cli/internal/client"
+	"github.com/pdrhp/portainer-go-cli/internal/envvars"
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var (
+	webhookTriggerEnv     []string
+	webhookTriggerWait    bool
+	webhookTriggerTimeout time.Duration
+)
+
+var stacksWebhookTriggerCmd = &cobra.Command{
+	Use:   "webhook-trigger [url|uuid]",
+	Short: "Redeploy a stack through its Git webhook",
+	Long: `Redeploy a Git stack by triggering its auto-update webhook. The webhook
+needs no authentication, so a CI system only knowing the webhook can redeploy
+that single stack.
+
+The webhook is referenced by its full URL, or by its ID together with
+--server-url or the server URL from config. Environment variable overrides
+are sent as query parameters.
+
+--wait follows the redeploy until the stack has converged, like
+'stacks redeploy --wait'. Observing the stack requires 'portainer auth'.
+
+Examples:
+  # Trigger a webhook by URL
+  portainer stacks webhook-trigger https://portainer.example.com/api/stacks/webhooks/05de31a2-79fa-4644-9c12-faa67e5c49f0
+
+  # Override environment variables
+  portainer stacks webhook-trigger 05de31a2-79fa-4644-9c12-faa67e5c49f0 --env VERSION=1.4.2
+
+  # Wait for the services to converge
+  portainer stacks webhook-trigger 05de31a2-79fa-4644-9c12-faa67e5c49f0 --wait --timeout 5m`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		baseURL, stack, id, err := parseWebhookRef(args[0])
+		if err != nil {
+			return err
+		}
+		if baseURL != "" && !stack {
+			return fmt.Errorf("%s is not a stack webhook URL, use 'portainer webhooks trigger' for service and container webhooks", args[0])
+		}
+
+		var env []types.Pair
+		if len(webhookTriggerEnv) > 0 {
+			env, err = envvars.Parse(strings.Join(webhookTriggerEnv, "\n"))
+			if err != nil {
+				return fmt.Errorf("invalid --env: %w", err)
+			}
+		}
+
+		// Look the stack up before triggering, so a missing login fails before the redeploy
+		var cl *client.Client
+		var target *types.Stack
+		var previousUpdates map[string]string
+		if webhookTriggerWait {
+			cl, _, err = newAPIClient(cmd)
+			if err != nil {
+				return fmt.Errorf("--wait needs access to the stack: %w", err)
+			}
+
+			stacks, err := cl.ListStacks(cmd.Context(), nil)
+			if err != nil {
+				return apiError(err, "list stacks", "stack")
+			}
+			for i := range stacks {
+				if stacks[i].AutoUpdate != nil && stacks[i].AutoUpdate.Webhook == id {
+					target = &stacks[i]
+					break
+				}
+			}
+			if target == nil {
+				return fmt.Errorf("no stack uses webhook %s", id)
+			}
+
+			previousUpdates, err = stackServiceUpdateMarks(cmd.Context(), cl, target)
+			if err != nil {
+				return err
+			}
+		}
+
+		webhookClient, err := newWebhookClient(cmd, baseURL)
+		if err != nil {
+			return err
+		}
+
+		if err := webhookClient.TriggerStackWebhook(cmd.Context(), id, env); err != nil {
+			return apiError(err, "trigger stack webhook", "stack webhook")
+		}
+
+		fmt.Println("Stack webhook triggered")
+
+		if target == nil {
+			return nil
+		}
+
+		return waitForStack(cmd.Context(), cl, target, previousUpdates, webhookTriggerTimeout)
+	},
+}
+
+func init() {
+	stacksWebhookTriggerCmd.Flags().StringArrayVar(&webhookTriggerEnv, "env", nil, "Environment variable override (format: KEY=value, can be used multiple times)")
+	stacksWebhookTriggerCmd.Flags().BoolVar(&webhookTriggerWait, "wait", false, "Wait until the services, containers or applications of the stack have converged")
+	stacksWebhookTriggerCmd.Flags().DurationVar(&webhookTriggerTimeout, "timeout", 10*time.Minute, "Maximum time to wait with --wait")
+}
@@ -0,0 +1,137 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/internal/printer"
+	"github.com/spf13/cobra"
+)
+
+var tagsCmd = &cobra.Command{
+	Use:   "tags",
+	Short: "Manage tags",
+	Long:  `Manage the Portainer tags used to organize environments and environment groups`,
+}
+
+func init() {
+	tagsCmd.AddCommand(tagsListCmd)
+	tagsCmd.AddCommand(tagsCreateCmd)
+	tagsCmd.AddCommand(tagsDeleteCmd)
+}
+
+var tagsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List tags",
+	Long: `List tags with the number of environments and environment groups using them.
+
+Examples:
+  # List tags
+  portainer tags list
+
+  # Output in JSON format
+  portainer tags list --output json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, _, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		tags, err := cl.ListTags(cmd.Context())
+		if err != nil {
+			return apiError(err, "list tags", "tag")
+		}
+
+		if len(tags) == 0 {
+			fmt.Println("No tags found.")
+			return nil
+		}
+
+		outputFormat := cmd.Flag("output").Value.String()
+
+		return printer.PrintTags(tags, outputFormat)
+	},
+}
+
+var tagsCreateCmd = &cobra.Command{
+	Use:   "create [name...]",
+	Short: "Create one or more tags",
+	Long: `Create one or more tags. Existing tags are left untouched.
+
+Examples:
+  # Create tags
+  portainer tags create prod staging`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, _, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		tags, err := cl.ListTags(cmd.Context())
+		if err != nil {
+			return apiError(err, "list tags", "tag")
+		}
+
+		for _, name := range args {
+			if tag := findTag(tags, name); tag != nil && tag.Name == name {
+				fmt.Printf("Tag '%s' already exists\n", name)
+				continue
+			}
+
+			tag, err := cl.CreateTag(cmd.Context(), name)
+			if err != nil {
+				return apiError(err, "create tag "+name, "tag")
+			}
+			fmt.Printf("Tag '%s' created successfully (ID: %d)\n", tag.Name, tag.ID)
+		}
+
+		return nil
+	},
+}
+
+var tagsDeleteCmd = &cobra.Command{
+	Use:     "delete [tag...]",
+	Aliases: []string{"rm"},
+	Short:   "Delete one or more tags",
+	Long: `Delete one or more tags referenced by name or ID. The tag is removed from the
+environments and environment groups using it.
+
+Examples:
+  # Delete a tag
+  portainer tags delete legacy`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, _, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		tags, err := cl.ListTags(cmd.Context())
+		if err != nil {
+			return apiError(err, "list tags", "tag")
+		}
+
+		failed := 0
+		for _, ref := range args {
+			tag := findTag(tags, ref)
+			if tag == nil {
+				fmt.Printf("Error: %s: tag not found\n", ref)
+				failed++
+				continue
+			}
+
+			if err := cl.DeleteTag(cmd.Context(), tag.ID); err != nil {
+				fmt.Printf("Error: %s: %v\n", ref, apiError(err, "delete tag", "tag"))
+				failed++
+				continue
+			}
+			fmt.Printf("Deleted %s\n", tag.Name)
+		}
+
+		if failed > 0 {
+			return fmt.Errorf("failed to delete %d of %d tags", failed, len(args))
+		}
+
+		return nil
+	},
+}
@@ -0,0 +1,254 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/internal/printer"
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var teamsAddMemberLeader bool
+
+var teamsCmd = &cobra.Command{
+	Use:   "teams",
+	Short: "Manage teams",
+	Long:  `Manage Portainer teams and their members`,
+}
+
+var teamsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List teams",
+	Long: `List Portainer teams with their leaders and members.
+
+Examples:
+  # List teams
+  portainer teams list
+
+  # Output in JSON format
+  portainer teams list --output json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, _, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		directory, err := loadAccessDirectory(cmd.Context(), cl)
+		if err != nil {
+			return err
+		}
+
+		teams := directory.teamDetails()
+		if len(teams) == 0 {
+			fmt.Println("No teams found.")
+			return nil
+		}
+
+		outputFormat := cmd.Flag("output").Value.String()
+
+		return printer.PrintTeams(teams, outputFormat)
+	},
+}
+
+var teamsCreateCmd = &cobra.Command{
+	Use:   "create [name...]",
+	Short: "Create one or more teams",
+	Long: `Create one or more teams. Existing teams are left untouched.
+
+Examples:
+  # Create teams
+  portainer teams create backend sre`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, _, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		directory, err := loadAccessDirectory(cmd.Context(), cl)
+		if err != nil {
+			return err
+		}
+
+		for _, name := range args {
+			if directory.findTeam(name) != nil {
+				fmt.Printf("Team '%s' already exists\n", name)
+				continue
+			}
+
+			team, err := cl.CreateTeam(cmd.Context(), types.TeamCreateRequest{Name: name})
+			if err != nil {
+				return apiError(err, "create team "+name, "team")
+			}
+			fmt.Printf("Team '%s' created successfully (ID: %d)\n", team.Name, team.ID)
+		}
+
+		return nil
+	},
+}
+
+var teamsDeleteCmd = &cobra.Command{
+	Use:     "delete [team...]",
+	Aliases: []string{"rm"},
+	Short:   "Delete one or more teams",
+	Long: `Delete one or more teams referenced by name or ID. Members are not deleted.
+
+Examples:
+  # Delete a team
+  portainer teams delete legacy-ops`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, _, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		directory, err := loadAccessDirectory(cmd.Context(), cl)
+		if err != nil {
+			return err
+		}
+
+		failed := 0
+		for _, ref := range args {
+			team := directory.findTeam(ref)
+			if team == nil {
+				fmt.Printf("Error: %s: team not found\n", ref)
+				failed++
+				continue
+			}
+
+			if err := cl.DeleteTeam(cmd.Context(), team.ID); err != nil {
+				fmt.Printf("Error: %s: %v\n", ref, apiError(err, "delete team", "team"))
+				failed++
+				continue
+			}
+			fmt.Printf("Deleted %s\n", team.Name)
+		}
+
+		if failed > 0 {
+			return fmt.Errorf("failed to delete %d of %d teams", failed, len(args))
+		}
+
+		return nil
+	},
+}
+
+var teamsAddMemberCmd = &cobra.Command{
+	Use:   "add-member [team] [user...]",
+	Short: "Add users to a team",
+	Long: `Add users to a team. Users already in the team are left as is, unless --leader
+promotes them.
+
+Examples:
+  # Add members
+  portainer teams add-member backend alice bob
+
+  # Make a user team leader
+  portainer teams add-member backend alice --leader`,
+	Args: cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		role := types.MembershipRoleMember
+		if teamsAddMemberLeader {
+			role = types.MembershipRoleLeader
+		}
+
+		cl, _, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		directory, err := loadAccessDirectory(cmd.Context(), cl)
+		if err != nil {
+			return err
+		}
+
+		team := directory.findTeam(args[0])
+		if team == nil {
+			return fmt.Errorf("team %q not found", args[0])
+		}
+
+		for _, ref := range args[1:] {
+			user := directory.findUser(ref)
+			if user == nil {
+				return fmt.Errorf("user %q not found", ref)
+			}
+
+			request := types.TeamMembershipCreateRequest{UserID: user.ID, TeamID: team.ID, Role: role}
+
+			membership := directory.findMembership(user.ID, team.ID)
+			switch {
+			case membership == nil:
+				if _, err := cl.CreateTeamMembership(cmd.Context(), request); err != nil {
+					return apiError(err, "add "+user.Username+" to team", "team")
+				}
+				fmt.Printf("Added %s to team '%s' as %s\n", user.Username, team.Name, role)
+			case teamsAddMemberLeader && membership.Role != role:
+				if _, err := cl.UpdateTeamMembership(cmd.Context(), membership.ID, request); err != nil {
+					return apiError(err, "promote "+user.Username, "team")
+				}
+				fmt.Printf("Promoted %s to leader of team '%s'\n", user.Username, team.Name)
+			default:
+				fmt.Printf("%s is already in team '%s'\n", user.Username, team.Name)
+			}
+		}
+
+		return nil
+	},
+}
+
+var teamsRemoveMemberCmd = &cobra.Command{
+	Use:   "remove-member [team] [user...]",
+	Short: "Remove users from a team",
+	Long: `Remove users from a team. Users that are not in the team are ignored.
+
+Examples:
+  # Remove a member
+  portainer teams remove-member backend bob`,
+	Args: cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, _, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		directory, err := loadAccessDirectory(cmd.Context(), cl)
+		if err != nil {
+			return err
+		}
+
+		team := directory.findTeam(args[0])
+		if team == nil {
+			return fmt.Errorf("team %q not found", args[0])
+		}
+
+		for _, ref := range args[1:] {
+			user := directory.findUser(ref)
+			if user == nil {
+				return fmt.Errorf("user %q not found", ref)
+			}
+
+			membership := directory.findMembership(user.ID, team.ID)
+			if membership == nil {
+				fmt.Printf("%s is not in team '%s'\n", user.Username, team.Name)
+				continue
+			}
+
+			if err := cl.DeleteTeamMembership(cmd.Context(), membership.ID); err != nil {
+				return apiError(err, "remove "+user.Username+" from team", "team")
+			}
+			fmt.Printf("Removed %s from team '%s'\n", user.Username, team.Name)
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	teamsAddMemberCmd.Flags().BoolVar(&teamsAddMemberLeader, "leader", false, "Add the users as team leaders")
+
+	teamsCmd.AddCommand(teamsListCmd)
+	teamsCmd.AddCommand(teamsCreateCmd)
+	teamsCmd.AddCommand(teamsDeleteCmd)
+	teamsCmd.AddCommand(teamsAddMemberCmd)
+	teamsCmd.AddCommand(teamsRemoveMemberCmd)
+}
@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/pdrhp/portainer-go-cli/internal/client"
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var templateVariablePattern = regexp.MustCompile(`\{\{\s*([A-Za-z0-9_.-]+)\s*\}\}`)
+
+var templatesCmd = &cobra.Command{
+	Use:   "templates",
+	Short: "Manage templates",
+	Long:  `Manage Portainer custom templates and deploy app templates`,
+}
+
+func init() {
+	templatesCmd.AddCommand(templatesCustomCmd)
+	templatesCmd.AddCommand(templatesAppCmd)
+}
+
+func lookupCustomTemplate(ctx context.Context, cl *client.Client, ref string) (*types.CustomTemplate, error) {
+	if id, err := strconv.Atoi(ref); err == nil {
+		template, err := cl.GetCustomTemplate(ctx, id)
+		if err != nil {
+			return nil, apiError(err, "get custom template", "custom template")
+		}
+		return template, nil
+	}
+
+	templates, err := cl.ListCustomTemplates(ctx)
+	if err != nil {
+		return nil, apiError(err, "list custom templates", "custom template")
+	}
+
+	var found *types.CustomTemplate
+	for i := range templates {
+		if templates[i].Title != ref {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("several custom templates are titled %q, use the template ID", ref)
+		}
+		found = &templates[i]
+	}
+
+	if found == nil {
+		return nil, fmt.Errorf("custom template %q not found", ref)
+	}
+
+	return found, nil
+}
+
+func resolveTemplateVariables(variables []types.CustomTemplateVariable, provided map[string]string) (map[string]string, error) {
+	declared := map[string]bool{}
+	values := map[string]string{}
+	for _, variable := range variables {
+		declared[variable.Name] = true
+		value, ok := provided[variable.Name]
+		if !ok {
+			value = variable.DefaultValue
+		}
+		if value == "" {
+			return nil, fmt.Errorf("variable %q has no default value, set it with --var %s=VALUE", variable.Name, variable.Name)
+		}
+		values[variable.Name] = value
+	}
+
+	for name := range provided {
+		if !declared[name] {
+			return nil, fmt.Errorf("template does not declare a variable named %q", name)
+		}
+	}
+
+	return values, nil
+}
+
+func renderTemplateVariables(content string, values map[string]string) string {
+	return templateVariablePattern.ReplaceAllStringFunc(content, func(match string) string {
+		name := templateVariablePattern.FindStringSubmatch(match)[1]
+		if value, ok := values[name]; ok {
+			return value
+		}
+		return match
+	})
+}
@@ -0,0 +1,266 @@
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/pdrhp/portainer-go-cli/internal/printer"
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var (
+	templatesAppListCategory string
+	templatesAppListType     string
+	templatesAppDeployName   string
+	templatesAppDeployEnv    []string
+	templatesAppDeploySwarm  string
+)
+
+var templatesAppCmd = &cobra.Command{
+	Use:     "app",
+	Aliases: []string{"apps"},
+	Short:   "Browse and deploy app templates",
+	Long:    `List the app templates of Portainer and deploy them as stacks`,
+}
+
+func init() {
+	templatesAppCmd.AddCommand(templatesAppListCmd)
+	templatesAppCmd.AddCommand(templatesAppDeployCmd)
+
+	templatesAppListCmd.Flags().StringVar(&templatesAppListCategory, "category", "", "Only list templates of this category")
+	templatesAppListCmd.Flags().StringVar(&templatesAppListType, "type", "", "Only list templates of this type (container|swarm|compose)")
+
+	templatesAppDeployCmd.Flags().StringVar(&templatesAppDeployName, "name", "", "Name of the stack (required)")
+	templatesAppDeployCmd.Flags().StringArrayVar(&templatesAppDeployEnv, "env", nil, "Template variable in KEY=value format (can be used multiple times)")
+	templatesAppDeployCmd.Flags().StringVar(&templatesAppDeploySwarm, "swarm-id", "", "Swarm cluster identifier (detected from the endpoint when omitted)")
+}
+
+func filterAppTemplates(templates []types.AppTemplate, category string, templateType types.AppTemplateType) []types.AppTemplate {
+	var filtered []types.AppTemplate
+	for _, template := range templates {
+		if templateType != 0 && template.Type != templateType {
+			continue
+		}
+		if category != "" && !slices.ContainsFunc(template.Categories, func(c string) bool {
+			return strings.EqualFold(c, category)
+		}) {
+			continue
+		}
+		filtered = append(filtered, template)
+	}
+
+	return filtered
+}
+
+func findAppTemplate(templates []types.AppTemplate, ref string) (*types.AppTemplate, error) {
+	if id, err := strconv.Atoi(ref); err == nil {
+		for i := range templates {
+			if templates[i].ID == id {
+				return &templates[i], nil
+			}
+		}
+	}
+
+	var found *types.AppTemplate
+	for i := range templates {
+		if !strings.EqualFold(templates[i].Title, ref) {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("several app templates are titled %q, use the template ID", ref)
+		}
+		found = &templates[i]
+	}
+
+	if found == nil {
+		return nil, fmt.Errorf("app template %q not found", ref)
+	}
+
+	return found, nil
+}
+
+func appTemplateEnv(template types.AppTemplate, provided map[string]string) ([]types.Pair, error) {
+	declared := map[string]bool{}
+	env := make([]types.Pair, 0, len(template.Env))
+	for _, variable := range template.Env {
+		declared[variable.Name] = true
+
+		value, ok := provided[variable.Name]
+		if ok && variable.Preset {
+			return nil, fmt.Errorf("variable %q is preset by the template and cannot be changed", variable.Name)
+		}
+		if !ok {
+			value = variable.Default
+			for _, option := range variable.Select {
+				if option.Default {
+					value = option.Value
+				}
+			}
+		}
+
+		if len(variable.Select) > 0 {
+			options := make([]string, 0, len(variable.Select))
+			for _, option := range variable.Select {
+				options = append(options, option.Value)
+			}
+			if !slices.Contains(options, value) {
+				return nil, fmt.Errorf("invalid value %q for variable %q, expected one of: %s", value, variable.Name, strings.Join(options, ", "))
+			}
+		}
+
+		if value == "" {
+			return nil, fmt.Errorf("variable %q is required, set it with --env %s=VALUE", variable.Name, variable.Name)
+		}
+
+		env = append(env, types.Pair{Name: variable.Name, Value: value})
+	}
+
+	for name := range provided {
+		if !declared[name] {
+			return nil, fmt.Errorf("template does not declare a variable named %q", name)
+		}
+	}
+
+	return env, nil
+}
+
+var templatesAppListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List app templates",
+	Long: `List the app templates available in Portainer.
+
+Examples:
+  # List app templates
+  portainer templates app list
+
+  # List swarm stack templates of a category
+  portainer templates app list --category database --type swarm
+
+  # Output in JSON format
+  portainer templates app list --output json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var templateType types.AppTemplateType
+		if templatesAppListType != "" {
+			var ok bool
+			templateType, ok = types.ParseAppTemplateType(templatesAppListType)
+			if !ok {
+				return fmt.Errorf("invalid --type %q, expected container, swarm or compose", templatesAppListType)
+			}
+		}
+
+		cl, _, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		templates, err := cl.ListAppTemplates(cmd.Context())
+		if err != nil {
+			return apiError(err, "list app templates", "app template")
+		}
+
+		templates = filterAppTemplates(templates, templatesAppListCategory, templateType)
+		if len(templates) == 0 {
+			fmt.Println("No app templates found.")
+			return nil
+		}
+
+		outputFormat := cmd.Flag("output").Value.String()
+
+		return printer.PrintAppTemplates(templates, outputFormat)
+	},
+}
+
+var templatesAppDeployCmd = &cobra.Command{
+	Use:   "deploy [template]",
+	Short: "Deploy an app template as a stack",
+	Long: `Deploy a swarm or compose app template, referenced by ID or title, as a stack.
+
+The variables of the template are set with --env. Variables that are not set
+use the default of the template; variables without a default are required.
+
+Examples:
+  # Deploy a swarm template
+  portainer templates app deploy Portainer-Agent --name agent --endpoint prod-swarm
+
+  # Deploy a compose template with variables
+  portainer templates app deploy "Wordpress" --name blog --env MYSQL_DATABASE_PASSWORD=secret`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if templatesAppDeployName == "" {
+			return fmt.Errorf("--name is required")
+		}
+
+		provided, err := parseKeyValueFlags(templatesAppDeployEnv, "env")
+		if err != nil {
+			return err
+		}
+
+		cl, cfg, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		templates, err := cl.ListAppTemplates(cmd.Context())
+		if err != nil {
+			return apiError(err, "list app templates", "app template")
+		}
+
+		template, err := findAppTemplate(templates, args[0])
+		if err != nil {
+			return err
+		}
+
+		if template.Type == types.AppTemplateTypeContainer || template.Repository == nil {
+			return fmt.Errorf("app template '%s' is a %s template, only swarm and compose templates can be deployed", template.Title, template.Type)
+		}
+
+		env, err := appTemplateEnv(*template, provided)
+		if err != nil {
+			return err
+		}
+
+		endpointID, err := requireEndpointID(cmd, cl, cfg)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Creating %s stack '%s' from app template '%s'...\n", template.Type, templatesAppDeployName, template.Title)
+
+		var stack *types.Stack
+		if template.Type == types.AppTemplateTypeSwarm {
+			swarmID := templatesAppDeploySwarm
+			if swarmID == "" {
+				swarmID, err = cl.GetSwarmID(cmd.Context(), endpointID)
+				if err != nil {
+					return fmt.Errorf("failed to detect swarm ID for endpoint %d (use --swarm-id): %w", endpointID, err)
+				}
+			}
+
+			stack, err = cl.CreateSwarmStackFromGit(cmd.Context(), endpointID, types.StackCreateSwarmGitPayload{
+				Name:            templatesAppDeployName,
+				RepositoryURL:   template.Repository.URL,
+				ComposeFile:     template.Repository.StackFile,
+				SwarmID:         swarmID,
+				Env:             env,
+				FromAppTemplate: true,
+			})
+		} else {
+			stack, err = cl.CreateComposeStackFromGit(cmd.Context(), endpointID, types.StackCreateComposeGitPayload{
+				Name:            templatesAppDeployName,
+				RepositoryURL:   template.Repository.URL,
+				ComposeFile:     template.Repository.StackFile,
+				Env:             env,
+				FromAppTemplate: true,
+			})
+		}
+		if err != nil {
+			return stackCreateError(err)
+		}
+
+		fmt.Printf("Stack '%s' created successfully with ID: %d\n", stack.Name, stack.ID)
+		return nil
+	},
+}
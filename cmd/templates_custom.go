@@ -0,0 +1,242 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pdrhp/portainer-go-cli/internal/printer"
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var (
+	templatesCustomInspectContent bool
+	templatesCustomCreateTitle    string
+	templatesCustomCreateDesc     string
+	templatesCustomCreateNote     string
+	templatesCustomCreateLogo     string
+	templatesCustomCreateFile     string
+	templatesCustomCreateType     string
+	templatesCustomCreatePlatform string
+	templatesCustomCreateVariable []string
+)
+
+var templatesCustomCmd = &cobra.Command{
+	Use:   "custom",
+	Short: "Manage custom templates",
+	Long:  `List, inspect, create and delete the custom stack templates stored in Portainer`,
+}
+
+func init() {
+	templatesCustomCmd.AddCommand(templatesCustomListCmd)
+	templatesCustomCmd.AddCommand(templatesCustomInspectCmd)
+	templatesCustomCmd.AddCommand(templatesCustomCreateCmd)
+	templatesCustomCmd.AddCommand(templatesCustomDeleteCmd)
+
+	templatesCustomInspectCmd.Flags().BoolVar(&templatesCustomInspectContent, "content", false, "Print the template file instead of its details")
+
+	templatesCustomCreateCmd.Flags().StringVar(&templatesCustomCreateTitle, "title", "", "Title of the template (required)")
+	templatesCustomCreateCmd.Flags().StringVar(&templatesCustomCreateDesc, "description", "", "Description of the template (required)")
+	templatesCustomCreateCmd.Flags().StringVar(&templatesCustomCreateNote, "note", "", "Note shown when deploying the template")
+	templatesCustomCreateCmd.Flags().StringVar(&templatesCustomCreateLogo, "logo", "", "URL of the template logo")
+	templatesCustomCreateCmd.Flags().StringVarP(&templatesCustomCreateFile, "file", "f", "", "Stack file of the template, - for stdin (required)")
+	templatesCustomCreateCmd.Flags().StringVar(&templatesCustomCreateType, "type", "swarm", "Stack type (swarm|compose|kubernetes)")
+	templatesCustomCreateCmd.Flags().StringVar(&templatesCustomCreatePlatform, "platform", "linux", "Platform (linux|windows)")
+	templatesCustomCreateCmd.Flags().StringArrayVar(&templatesCustomCreateVariable, "variable", nil, "Variable in NAME or NAME=DEFAULT format, used as {{ NAME }} in the file (can be used multiple times)")
+}
+
+func parseTemplateVariableFlags(values []string) ([]types.CustomTemplateVariable, error) {
+	variables := make([]types.CustomTemplateVariable, 0, len(values))
+	for _, value := range values {
+		name, defaultValue, _ := strings.Cut(value, "=")
+		if strings.TrimSpace(name) == "" || strings.ContainsAny(name, " {}") {
+			return nil, fmt.Errorf("invalid --variable value %q, expected NAME or NAME=DEFAULT", value)
+		}
+		variables = append(variables, types.CustomTemplateVariable{
+			Name:         name,
+			Label:        name,
+			DefaultValue: defaultValue,
+		})
+	}
+
+	return variables, nil
+}
+
+var templatesCustomListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List custom templates",
+	Long: `List custom templates.
+
+Examples:
+  # List custom templates
+  portainer templates custom list
+
+  # Output in JSON format
+  portainer templates custom list --output json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, _, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		templates, err := cl.ListCustomTemplates(cmd.Context())
+		if err != nil {
+			return apiError(err, "list custom templates", "custom template")
+		}
+
+		if len(templates) == 0 {
+			fmt.Println("No custom templates found.")
+			return nil
+		}
+
+		outputFormat := cmd.Flag("output").Value.String()
+
+		return printer.PrintCustomTemplates(templates, outputFormat)
+	},
+}
+
+var templatesCustomInspectCmd = &cobra.Command{
+	Use:   "inspect [template]",
+	Short: "Show details of a custom template",
+	Long: `Show details of a custom template referenced by ID or title, including its variables.
+
+Examples:
+  # Inspect a template
+  portainer templates custom inspect "Web API"
+
+  # Print the template file
+  portainer templates custom inspect 12 --content`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, _, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		template, err := lookupCustomTemplate(cmd.Context(), cl, args[0])
+		if err != nil {
+			return err
+		}
+
+		if templatesCustomInspectContent {
+			content, err := cl.GetCustomTemplateFile(cmd.Context(), template.ID)
+			if err != nil {
+				return apiError(err, "get custom template file", "custom template")
+			}
+			fmt.Print(content)
+			return nil
+		}
+
+		outputFormat := cmd.Flag("output").Value.String()
+
+		return printer.PrintCustomTemplate(*template, outputFormat)
+	},
+}
+
+var templatesCustomCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a custom template",
+	Long: `Create a custom template from a local stack file.
+
+Examples:
+  # Create a swarm template with variables
+  portainer templates custom create \
+    --title "Web API" \
+    --description "Standard web API stack" \
+    --file api-stack.yml \
+    --variable SERVICE_NAME \
+    --variable REPLICAS=2
+
+  # Create a compose template from stdin
+  cat docker-compose.yml | portainer templates custom create --title Cache --description "Redis cache" --type compose --file -`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if templatesCustomCreateTitle == "" {
+			return fmt.Errorf("--title is required")
+		}
+		if templatesCustomCreateDesc == "" {
+			return fmt.Errorf("--description is required")
+		}
+
+		stackType, ok := types.ParseStackType(templatesCustomCreateType)
+		if !ok {
+			return fmt.Errorf("invalid --type %q, expected swarm, compose or kubernetes", templatesCustomCreateType)
+		}
+
+		platform, ok := types.ParseTemplatePlatform(templatesCustomCreatePlatform)
+		if !ok {
+			return fmt.Errorf("invalid --platform %q, expected linux or windows", templatesCustomCreatePlatform)
+		}
+
+		variables, err := parseTemplateVariableFlags(templatesCustomCreateVariable)
+		if err != nil {
+			return err
+		}
+
+		content, err := readObjectData(cmd, templatesCustomCreateFile, "")
+		if err != nil {
+			return err
+		}
+
+		cl, _, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		template, err := cl.CreateCustomTemplate(cmd.Context(), types.CustomTemplateCreateRequest{
+			Title:       templatesCustomCreateTitle,
+			Description: templatesCustomCreateDesc,
+			Note:        templatesCustomCreateNote,
+			Logo:        templatesCustomCreateLogo,
+			FileContent: string(content),
+			Platform:    platform,
+			Type:        stackType,
+			Variables:   variables,
+		})
+		if err != nil {
+			return apiError(err, "create custom template", "custom template")
+		}
+
+		fmt.Printf("Custom template '%s' created successfully (ID: %d)\n", template.Title, template.ID)
+		return nil
+	},
+}
+
+var templatesCustomDeleteCmd = &cobra.Command{
+	Use:     "delete [template...]",
+	Aliases: []string{"rm"},
+	Short:   "Delete one or more custom templates",
+	Long: `Delete one or more custom templates referenced by ID or title. Stacks deployed from them are not affected.
+
+Examples:
+  # Delete templates
+  portainer templates custom delete "Web API" 12`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, _, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		failed := 0
+		for _, ref := range args {
+			template, err := lookupCustomTemplate(cmd.Context(), cl, ref)
+			if err != nil {
+				fmt.Printf("Error: %s: %v\n", ref, err)
+				failed++
+				continue
+			}
+			if err := cl.DeleteCustomTemplate(cmd.Context(), template.ID); err != nil {
+				fmt.Printf("Error: %s: %v\n", ref, apiError(err, "delete custom template", "custom template"))
+				failed++
+				continue
+			}
+			fmt.Printf("Deleted custom template %s\n", template.Title)
+		}
+
+		if failed > 0 {
+			return fmt.Errorf("failed to delete %d of %d custom templates", failed, len(args))
+		}
+
+		return nil
+	},
+}
@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveTemplateVariables(t *testing.T) {
+	variables := []types.CustomTemplateVariable{
+		{Name: "SERVICE_NAME"},
+		{Name: "REPLICAS", DefaultValue: "2"},
+	}
+
+	values, err := resolveTemplateVariables(variables, map[string]string{"SERVICE_NAME": "orders"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"SERVICE_NAME": "orders", "REPLICAS": "2"}, values)
+
+	_, err = resolveTemplateVariables(variables, map[string]string{"REPLICAS": "3"})
+	assert.ErrorContains(t, err, `variable "SERVICE_NAME" has no default value`)
+
+	_, err = resolveTemplateVariables(variables, map[string]string{"SERVICE_NAME": "orders", "PORT": "80"})
+	assert.ErrorContains(t, err, `does not declare a variable named "PORT"`)
+}
+
+func TestRenderTemplateVariables(t *testing.T) {
+	content := "services:\n  {{ SERVICE_NAME }}:\n    deploy:\n      replicas: {{REPLICAS}}\n    image: ${IMAGE}\n    labels:\n      - \"{{ UNKNOWN }}\"\n"
+
+	rendered := renderTemplateVariables(content, map[string]string{"SERVICE_NAME": "orders", "REPLICAS": "3"})
+
+	assert.Equal(t, "services:\n  orders:\n    deploy:\n      replicas: 3\n    image: ${IMAGE}\n    labels:\n      - \"{{ UNKNOWN }}\"\n", rendered)
+}
+
+func TestAppTemplateEnv(t *testing.T) {
+	template := types.AppTemplate{
+		Title: "Wordpress",
+		Env: []types.AppTemplateEnv{
+			{Name: "MYSQL_DATABASE_PASSWORD"},
+			{Name: "WORDPRESS_DB_NAME", Default: "wordpress"},
+			{Name: "AGENT_CLUSTER_ADDR", Default: "tasks.agent", Preset: true},
+			{Name: "TIER", Select: []types.AppTemplateEnvSelect{{Value: "small", Default: true}, {Value: "large"}}},
+		},
+	}
+
+	env, err := appTemplateEnv(template, map[string]string{"MYSQL_DATABASE_PASSWORD": "secret"})
+	require.NoError(t, err)
+	assert.Equal(t, []types.Pair{
+		{Name: "MYSQL_DATABASE_PASSWORD", Value: "secret"},
+		{Name: "WORDPRESS_DB_NAME", Value: "wordpress"},
+		{Name: "AGENT_CLUSTER_ADDR", Value: "tasks.agent"},
+		{Name: "TIER", Value: "small"},
+	}, env)
+
+	tests := []struct {
+		name     string
+		provided map[string]string
+		err      string
+	}{
+		{name: "missing required", provided: map[string]string{}, err: `variable "MYSQL_DATABASE_PASSWORD" is required`},
+		{name: "preset override", provided: map[string]string{"MYSQL_DATABASE_PASSWORD": "x", "AGENT_CLUSTER_ADDR": "other"}, err: "preset by the template"},
+		{name: "invalid select", provided: map[string]string{"MYSQL_DATABASE_PASSWORD": "x", "TIER": "huge"}, err: "expected one of: small, large"},
+		{name: "undeclared", provided: map[string]string{"MYSQL_DATABASE_PASSWORD": "x", "PORT": "80"}, err: `does not declare a variable named "PORT"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := appTemplateEnv(template, tt.provided)
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func TestFilterAppTemplates(t *testing.T) {
+	templates := []types.AppTemplate{
+		{ID: 1, Title: "Nginx", Type: types.AppTemplateTypeContainer, Categories: []string{"webserver"}},
+		{ID: 2, Title: "Portainer Agent", Type: types.AppTemplateTypeSwarm, Categories: []string{"portainer"}},
+		{ID: 3, Title: "Wordpress", Type: types.AppTemplateTypeCompose, Categories: []string{"CMS"}},
+	}
+
+	assert.Len(t, filterAppTemplates(templates, "", 0), 3)
+	assert.Equal(t, []types.AppTemplate{templates[2]}, filterAppTemplates(templates, "cms", 0))
+	assert.Equal(t, []types.AppTemplate{templates[1]}, filterAppTemplates(templates, "", types.AppTemplateTypeSwarm))
+	assert.Empty(t, filterAppTemplates(templates, "cms", types.AppTemplateTypeSwarm))
+
+	template, err := findAppTemplate(templates, "wordpress")
+	require.NoError(t, err)
+	assert.Equal(t, 3, template.ID)
+}
@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/internal/client"
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var tokensForUser string
+
+var tokensCmd = &cobra.Command{
+	Use:   "tokens",
+	Short: "Manage API access tokens",
+	Long:  `Manage Portainer API access tokens (API keys) of the current user or of a service account`,
+}
+
+func init() {
+	tokensCmd.PersistentFlags().StringVar(&tokensForUser, "for-user", "", "Manage the tokens of another user, by username or ID (admin only)")
+
+	tokensCmd.AddCommand(tokensListCmd)
+	tokensCmd.AddCommand(tokensCreateCmd)
+	tokensCmd.AddCommand(tokensRevokeCmd)
+}
+
+func resolveTokenUser(ctx context.Context, cl *client.Client, ref string) (*types.User, error) {
+	if ref == "" {
+		user, err := cl.GetCurrentUser(ctx)
+		if err != nil {
+			return nil, apiError(err, "get current user", "user")
+		}
+		return user, nil
+	}
+
+	users, err := cl.ListUsers(ctx)
+	if err != nil {
+		return nil, apiError(err, "list users", "user")
+	}
+
+	directory := &accessDirectory{users: users}
+	user := directory.findUser(ref)
+	if user == nil {
+		return nil, fmt.Errorf("user %q not found", ref)
+	}
+
+	return user, nil
+}
@@ -0,0 +1,140 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pdrhp/portainer-go-cli/internal/client"
+	"github.com/pdrhp/portainer-go-cli/internal/config"
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var (
+	tokensCreateDescription   string
+	tokensCreateSave          bool
+	tokensCreatePasswordStdin bool
+)
+
+var tokensCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create an access token",
+	Long: `Create an API access token. The token is printed only once and cannot be
+retrieved afterwards.
+
+With --save the token is stored as api-key in the CLI configuration and used
+for every following request instead of the session token from 'portainer auth'.
+Only your own tokens can be saved, a token created with --for-user for another
+user is printed only.
+
+Portainer asks for the password of the user when creating a token. It is read
+from stdin with --password-stdin, otherwise the password from the config is used.
+
+Portainer only creates tokens for the user of the current session. With
+--for-user naming another user, such as a service account, the CLI logs in as
+that user with the password from --password-stdin and creates the token in
+that session.
+
+Examples:
+  # Create a token and use it from now on
+  portainer tokens create --description laptop --save
+
+  # Create a token for a service account
+  echo "$CI_PASSWORD" | portainer tokens create --description gitlab --for-user ci-bot --password-stdin`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if tokensCreateDescription == "" {
+			return fmt.Errorf("--description is required")
+		}
+
+		cl, cfg, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		password := ""
+		if tokensCreatePasswordStdin {
+			data, err := io.ReadAll(cmd.InOrStdin())
+			if err != nil {
+				return fmt.Errorf("failed to read password from stdin: %w", err)
+			}
+			password = strings.TrimRight(string(data), "\r\n")
+		} else if tokensForUser == "" {
+			password = cfg.Password
+		}
+
+		user, err := resolveTokenUser(cmd.Context(), cl, tokensForUser)
+		if err != nil {
+			return err
+		}
+
+		caller := user
+		if tokensForUser != "" {
+			caller, err = cl.GetCurrentUser(cmd.Context())
+			if err != nil {
+				return apiError(err, "get current user", "user")
+			}
+		}
+
+		// A saved api-key is used for every later command, so it must belong to the caller
+		if tokensCreateSave && caller.ID != user.ID {
+			return fmt.Errorf("--save cannot store the token of %s, every later command would run as that user", user.Username)
+		}
+
+		tokenClient, err := tokenClientFor(cmd.Context(), cl, caller, user, password)
+		if err != nil {
+			return err
+		}
+
+		resp, err := tokenClient.CreateAPIToken(cmd.Context(), user.ID, types.APITokenCreateRequest{
+			Description: tokensCreateDescription,
+			Password:    password,
+		})
+		if err != nil {
+			return apiError(err, "create access token", "user")
+		}
+
+		fmt.Printf("Access token '%s' created for %s (ID: %d)\n", resp.APIKey.Description, user.Username, resp.APIKey.ID)
+
+		if tokensCreateSave {
+			cfg.APIKey = resp.RawAPIKey
+			if err := config.Save(cfg); err != nil {
+				return fmt.Errorf("token created but could not be saved, keep it now: %s: %w", resp.RawAPIKey, err)
+			}
+			fmt.Println("Token saved as api-key in the configuration")
+		}
+
+		fmt.Printf("Token: %s\n", resp.RawAPIKey)
+		fmt.Println("Store it now, it will not be shown again.")
+
+		return nil
+	},
+}
+
+func tokenClientFor(ctx context.Context, cl *client.Client, caller *types.User, user *types.User, password string) (*client.Client, error) {
+	if caller.ID == user.ID {
+		return cl, nil
+	}
+
+	// Portainer only creates tokens for the user of the session, even for
+	// administrators, so the token is created after logging in as that user
+	if password == "" {
+		return nil, fmt.Errorf("the password of %s is required to create a token for it, use --password-stdin", user.Username)
+	}
+
+	userClient := client.New(cl.BaseURL())
+	jwt, err := userClient.Authenticate(ctx, user.Username, password)
+	if err != nil {
+		return nil, apiError(err, "log in as "+user.Username, "user")
+	}
+	userClient.SetToken(jwt)
+
+	return userClient, nil
+}
+
+func init() {
+	tokensCreateCmd.Flags().StringVar(&tokensCreateDescription, "description", "", "Description of the token (required)")
+	tokensCreateCmd.Flags().BoolVar(&tokensCreateSave, "save", false, "Store the token as api-key in the configuration")
+	tokensCreateCmd.Flags().BoolVar(&tokensCreatePasswordStdin, "password-stdin", false, "Read the user's password from stdin")
+}
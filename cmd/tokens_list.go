@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/internal/printer"
+	"github.com/spf13/cobra"
+)
+
+var tokensListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List access tokens",
+	Long: `List the API access tokens of the current user. The token values themselves
+are never returned by Portainer, only their prefix.
+
+Examples:
+  # List your tokens
+  portainer tokens list
+
+  # List the tokens of a service account
+  portainer tokens list --for-user ci-bot`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, _, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		user, err := resolveTokenUser(cmd.Context(), cl, tokensForUser)
+		if err != nil {
+			return err
+		}
+
+		tokens, err := cl.ListAPITokens(cmd.Context(), user.ID)
+		if err != nil {
+			return apiError(err, "list access tokens", "user")
+		}
+
+		if len(tokens) == 0 {
+			fmt.Printf("No access tokens found for %s.\n", user.Username)
+			return nil
+		}
+
+		outputFormat := cmd.Flag("output").Value.String()
+
+		return printer.PrintAPITokens(tokens, outputFormat)
+	},
+}
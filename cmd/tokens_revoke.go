@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var tokensRevokeCmd = &cobra.Command{
+	Use:     "revoke [token...]",
+	Aliases: []string{"rm"},
+	Short:   "Revoke one or more access tokens",
+	Long: `Revoke one or more API access tokens referenced by ID or description.
+
+Examples:
+  # Revoke a token by ID
+  portainer tokens revoke 4
+
+  # Revoke the token of a service account by description
+  portainer tokens revoke gitlab --for-user ci-bot`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, _, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		user, err := resolveTokenUser(cmd.Context(), cl, tokensForUser)
+		if err != nil {
+			return err
+		}
+
+		tokens, err := cl.ListAPITokens(cmd.Context(), user.ID)
+		if err != nil {
+			return apiError(err, "list access tokens", "user")
+		}
+
+		failed := 0
+		for _, ref := range args {
+			token, err := findAPIToken(tokens, ref)
+			if err != nil {
+				fmt.Printf("Error: %s: %v\n", ref, err)
+				failed++
+				continue
+			}
+
+			if err := cl.RevokeAPIToken(cmd.Context(), user.ID, token.ID); err != nil {
+				fmt.Printf("Error: %s: %v\n", ref, apiError(err, "revoke access token", "token"))
+				failed++
+				continue
+			}
+			fmt.Printf("Revoked token '%s' (ID: %d)\n", token.Description, token.ID)
+		}
+
+		if failed > 0 {
+			return fmt.Errorf("failed to revoke %d of %d tokens", failed, len(args))
+		}
+
+		return nil
+	},
+}
+
+func findAPIToken(tokens []types.APIToken, ref string) (*types.APIToken, error) {
+	if id, err := strconv.Atoi(ref); err == nil {
+		for i := range tokens {
+			if tokens[i].ID == id {
+				return &tokens[i], nil
+			}
+		}
+	}
+
+	var match *types.APIToken
+	for i := range tokens {
+		if tokens[i].Description != ref {
+			continue
+		}
+		if match != nil {
+			return nil, fmt.Errorf("several tokens are named %q, use the token ID", ref)
+		}
+		match = &tokens[i]
+	}
+	if match == nil {
+		return nil, fmt.Errorf("token not found")
+	}
+
+	return match, nil
+}
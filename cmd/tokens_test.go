@@ -0,0 +1,76 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pdrhp/portainer-go-cli/internal/client"
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindAPIToken(t *testing.T) {
+	tokens := []types.APIToken{
+		{ID: 1, Description: "laptop"},
+		{ID: 2, Description: "gitlab"},
+		{ID: 3, Description: "gitlab"},
+	}
+
+	token, err := findAPIToken(tokens, "laptop")
+	require.NoError(t, err)
+	assert.Equal(t, 1, token.ID)
+
+	token, err = findAPIToken(tokens, "3")
+	require.NoError(t, err)
+	assert.Equal(t, 3, token.ID)
+
+	_, err = findAPIToken(tokens, "gitlab")
+	assert.ErrorContains(t, err, "use the token ID")
+
+	_, err = findAPIToken(tokens, "missing")
+	assert.Error(t, err)
+}
+
+func TestTokenClientFor_OtherUserLogsInFirst(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "POST /api/auth":
+			var req client.AuthRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			assert.Equal(t, "ci-bot", req.Username)
+			assert.Equal(t, "bot-password", req.Password)
+			w.Write([]byte(`{"jwt":"ci-bot-jwt"}`))
+		case "POST /api/users/7/tokens":
+			assert.Equal(t, "Bearer ci-bot-jwt", r.Header.Get("Authorization"))
+			assert.Empty(t, r.Header.Get("X-API-Key"))
+			w.Write([]byte(`{"rawAPIKey":"ptr_bot","apiKey":{"id":1,"userId":7,"description":"gitlab"}}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	cl := client.New(server.URL)
+	cl.SetAPIKey("ptr_admin")
+
+	admin := &types.User{ID: 1, Username: "admin"}
+	bot := &types.User{ID: 7, Username: "ci-bot"}
+
+	tokenClient, err := tokenClientFor(context.Background(), cl, admin, bot, "bot-password")
+	require.NoError(t, err)
+
+	resp, err := tokenClient.CreateAPIToken(context.Background(), bot.ID, types.APITokenCreateRequest{Description: "gitlab", Password: "bot-password"})
+	require.NoError(t, err)
+	assert.Equal(t, "ptr_bot", resp.RawAPIKey)
+
+	_, err = tokenClientFor(context.Background(), cl, admin, bot, "")
+	assert.ErrorContains(t, err, "--password-stdin")
+
+	self, err := tokenClientFor(context.Background(), cl, admin, admin, "")
+	require.NoError(t, err)
+	assert.Same(t, cl, self)
+}
@@ -0,0 +1,188 @@
+package cmd
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/pdrhp/portainer-go-cli/internal/client"
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	generatedPasswordLength  = 20
+	generatedPasswordCharset = "abcdefghijkmnopqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ23456789-_.!"
+)
+
+var usersCmd = &cobra.Command{
+	Use:   "users",
+	Short: "Manage users",
+	Long:  `Manage Portainer users, their role and their team memberships`,
+}
+
+func init() {
+	usersCmd.AddCommand(usersListCmd)
+	usersCmd.AddCommand(usersCreateCmd)
+	usersCmd.AddCommand(usersDeleteCmd)
+	usersCmd.AddCommand(usersSetRoleCmd)
+	usersCmd.AddCommand(usersImportCmd)
+}
+
+type accessDirectory struct {
+	users       []types.User
+	teams       []types.Team
+	memberships []types.TeamMembership
+}
+
+func loadAccessDirectory(ctx context.Context, cl *client.Client) (*accessDirectory, error) {
+	users, err := cl.ListUsers(ctx)
+	if err != nil {
+		return nil, apiError(err, "list users", "user")
+	}
+
+	teams, err := cl.ListTeams(ctx)
+	if err != nil {
+		return nil, apiError(err, "list teams", "team")
+	}
+
+	memberships, err := cl.ListTeamMemberships(ctx)
+	if err != nil {
+		return nil, apiError(err, "list team memberships", "team")
+	}
+
+	return &accessDirectory{users: users, teams: teams, memberships: memberships}, nil
+}
+
+func (d *accessDirectory) findUser(ref string) *types.User {
+	id, _ := strconv.Atoi(ref)
+	for i := range d.users {
+		if d.users[i].Username == ref || (id > 0 && d.users[i].ID == id) {
+			return &d.users[i]
+		}
+	}
+	return nil
+}
+
+func (d *accessDirectory) findTeam(ref string) *types.Team {
+	id, _ := strconv.Atoi(ref)
+	for i := range d.teams {
+		if d.teams[i].Name == ref || (id > 0 && d.teams[i].ID == id) {
+			return &d.teams[i]
+		}
+	}
+	return nil
+}
+
+// Portainer compares user and team names case-insensitively, and a file
+// entry is always a name even when it looks like an ID
+func (d *accessDirectory) findUserByName(name string) *types.User {
+	for i := range d.users {
+		if strings.EqualFold(d.users[i].Username, name) {
+			return &d.users[i]
+		}
+	}
+	return nil
+}
+
+func (d *accessDirectory) findTeamByName(name string) *types.Team {
+	for i := range d.teams {
+		if strings.EqualFold(d.teams[i].Name, name) {
+			return &d.teams[i]
+		}
+	}
+	return nil
+}
+
+func (d *accessDirectory) findMembership(userID int, teamID int) *types.TeamMembership {
+	for i := range d.memberships {
+		if d.memberships[i].UserID == userID && d.memberships[i].TeamID == teamID {
+			return &d.memberships[i]
+		}
+	}
+	return nil
+}
+
+func (d *accessDirectory) username(userID int) string {
+	if d != nil {
+		for _, user := range d.users {
+			if user.ID == userID {
+				return user.Username
+			}
+		}
+	}
+	return strconv.Itoa(userID)
+}
+
+func (d *accessDirectory) teamName(teamID int) string {
+	if d != nil {
+		for _, team := range d.teams {
+			if team.ID == teamID {
+				return team.Name
+			}
+		}
+	}
+	return strconv.Itoa(teamID)
+}
+
+func (d *accessDirectory) userDetails() []types.UserDetails {
+	teamNames := map[int]string{}
+	for _, team := range d.teams {
+		teamNames[team.ID] = team.Name
+	}
+
+	details := make([]types.UserDetails, 0, len(d.users))
+	for _, user := range d.users {
+		var teams []string
+		for _, membership := range d.memberships {
+			if membership.UserID == user.ID {
+				teams = append(teams, teamNames[membership.TeamID])
+			}
+		}
+		details = append(details, types.UserDetails{User: user, Teams: teams})
+	}
+
+	return details
+}
+
+func (d *accessDirectory) teamDetails() []types.TeamDetails {
+	usernames := map[int]string{}
+	for _, user := range d.users {
+		usernames[user.ID] = user.Username
+	}
+
+	details := make([]types.TeamDetails, 0, len(d.teams))
+	for _, team := range d.teams {
+		detail := types.TeamDetails{Team: team}
+		for _, membership := range d.memberships {
+			if membership.TeamID != team.ID {
+				continue
+			}
+			if membership.Role == types.MembershipRoleLeader {
+				detail.Leaders = append(detail.Leaders, usernames[membership.UserID])
+			} else {
+				detail.Members = append(detail.Members, usernames[membership.UserID])
+			}
+		}
+		details = append(details, detail)
+	}
+
+	return details
+}
+
+func generatePassword() (string, error) {
+	max := big.NewInt(int64(len(generatedPasswordCharset)))
+	password := make([]byte, generatedPasswordLength)
+	for i := range password {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", fmt.Errorf("failed to generate password: %w", err)
+		}
+		password[i] = generatedPasswordCharset[n.Int64()]
+	}
+
+	return string(password), nil
+}
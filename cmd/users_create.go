@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var (
+	usersCreateRole          string
+	usersCreatePasswordStdin bool
+	usersCreateTeams         []string
+)
+
+var usersCreateCmd = &cobra.Command{
+	Use:   "create [username]",
+	Short: "Create a user",
+	Long: `Create a Portainer user.
+
+The password is read from stdin with --password-stdin. Otherwise a random
+password is generated and printed once.
+
+Examples:
+  # Create a standard user with a generated password
+  portainer users create alice
+
+  # Create an administrator with a password from stdin
+  echo "$PASSWORD" | portainer users create bob --role admin --password-stdin
+
+  # Create a user and add them to teams
+  portainer users create carol --team backend --team sre`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		role, ok := types.ParseUserRole(usersCreateRole)
+		if !ok {
+			return fmt.Errorf("invalid role %q. Use admin or standard", usersCreateRole)
+		}
+
+		var password string
+		generated := false
+		if usersCreatePasswordStdin {
+			data, err := io.ReadAll(cmd.InOrStdin())
+			if err != nil {
+				return fmt.Errorf("failed to read password from stdin: %w", err)
+			}
+			password = strings.TrimRight(string(data), "\r\n")
+			if password == "" {
+				return fmt.Errorf("password read from stdin is empty")
+			}
+		} else {
+			var err error
+			password, err = generatePassword()
+			if err != nil {
+				return err
+			}
+			generated = true
+		}
+
+		cl, _, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		directory, err := loadAccessDirectory(cmd.Context(), cl)
+		if err != nil {
+			return err
+		}
+
+		if directory.findUser(args[0]) != nil {
+			return fmt.Errorf("user %q already exists", args[0])
+		}
+
+		var teams []types.Team
+		for _, ref := range usersCreateTeams {
+			team := directory.findTeam(ref)
+			if team == nil {
+				return fmt.Errorf("team %q not found", ref)
+			}
+			teams = append(teams, *team)
+		}
+
+		user, err := cl.CreateUser(cmd.Context(), types.UserCreateRequest{
+			Username: args[0],
+			Password: password,
+			Role:     role,
+		})
+		if err != nil {
+			return apiError(err, "create user", "user")
+		}
+		fmt.Printf("User '%s' created successfully (ID: %d, role: %s)\n", user.Username, user.ID, role)
+
+		for _, team := range teams {
+			_, err := cl.CreateTeamMembership(cmd.Context(), types.TeamMembershipCreateRequest{
+				UserID: user.ID,
+				TeamID: team.ID,
+				Role:   types.MembershipRoleMember,
+			})
+			if err != nil {
+				return apiError(err, "add user to team "+team.Name, "team")
+			}
+			fmt.Printf("Added to team '%s'\n", team.Name)
+		}
+
+		if generated {
+			fmt.Printf("Generated password: %s\n", password)
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	usersCreateCmd.Flags().StringVar(&usersCreateRole, "role", "standard", "User role: admin or standard")
+	usersCreateCmd.Flags().BoolVar(&usersCreatePasswordStdin, "password-stdin", false, "Read the password from stdin instead of generating one")
+	usersCreateCmd.Flags().StringArrayVar(&usersCreateTeams, "team", []string{}, "Team to add the user to (can be used multiple times)")
+}
@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var usersDeleteCmd = &cobra.Command{
+	Use:     "delete [user...]",
+	Aliases: []string{"rm"},
+	Short:   "Delete one or more users",
+	Long: `Delete one or more users referenced by username or ID.
+
+Examples:
+  # Delete users
+  portainer users delete alice bob`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, _, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		directory, err := loadAccessDirectory(cmd.Context(), cl)
+		if err != nil {
+			return err
+		}
+
+		failed := 0
+		for _, ref := range args {
+			user := directory.findUser(ref)
+			if user == nil {
+				fmt.Printf("Error: %s: user not found\n", ref)
+				failed++
+				continue
+			}
+
+			if err := cl.DeleteUser(cmd.Context(), user.ID); err != nil {
+				fmt.Printf("Error: %s: %v\n", ref, apiError(err, "delete user", "user"))
+				failed++
+				continue
+			}
+			fmt.Printf("Deleted %s\n", user.Username)
+		}
+
+		if failed > 0 {
+			return fmt.Errorf("failed to delete %d of %d users", failed, len(args))
+		}
+
+		return nil
+	},
+}
@@ -0,0 +1,290 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pdrhp/portainer-go-cli/internal/client"
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+var usersImportDryRun bool
+
+type userImportEntry struct {
+	Username string   `yaml:"username"`
+	Role     string   `yaml:"role"`
+	Teams    []string `yaml:"teams"`
+}
+
+type userImportFile struct {
+	Users []userImportEntry `yaml:"users"`
+}
+
+var usersImportCmd = &cobra.Command{
+	Use:   "import [file]",
+	Short: "Create users, teams and memberships from a CSV or YAML file",
+	Long: `Create users, teams and team memberships from a CSV or YAML file.
+
+The import is idempotent: existing users are kept (their role is aligned with
+the file when it sets one), missing teams are created and existing memberships
+are left as is. Users created without a role in the file are standard users.
+Users and memberships that are not in the file are never removed. New users get
+a generated password, printed once at the end.
+
+CSV files need a header with a username column, and optional role and teams
+columns; teams are separated by ';'.
+
+  username,role,teams
+  alice,standard,backend;sre
+  bob,admin,
+
+YAML files list the users under a users key:
+
+  users:
+    - username: alice
+      role: standard
+      teams: [backend, sre]
+    - username: bob
+      role: admin
+
+Examples:
+  # Preview the changes
+  portainer users import engineers.csv --dry-run
+
+  # Apply them
+  portainer users import engineers.yaml`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		entries, err := readUserImportFile(args[0])
+		if err != nil {
+			return err
+		}
+
+		cl, _, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		directory, err := loadAccessDirectory(cmd.Context(), cl)
+		if err != nil {
+			return err
+		}
+
+		passwords, err := importUsers(cmd.Context(), cl, directory, entries, usersImportDryRun)
+		if len(passwords) > 0 {
+			fmt.Println("\nGenerated passwords (shown only once):")
+			for _, entry := range entries {
+				if password, ok := passwords[entry.Username]; ok {
+					fmt.Printf("  %s\t%s\n", entry.Username, password)
+				}
+			}
+		}
+
+		return err
+	},
+}
+
+func readUserImportFile(path string) ([]userImportEntry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read import file: %w", err)
+	}
+
+	var entries []userImportEntry
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		entries, err = parseUserImportCSV(bytes.NewReader(data))
+	case ".yaml", ".yml":
+		entries, err = parseUserImportYAML(data)
+	default:
+		return nil, fmt.Errorf("unsupported import file %q. Use a .csv, .yaml or .yml file", path)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[string]bool{}
+	for i, entry := range entries {
+		if entry.Username == "" {
+			return nil, fmt.Errorf("entry %d: username is required", i+1)
+		}
+		if seen[strings.ToLower(entry.Username)] {
+			return nil, fmt.Errorf("entry %d: duplicate username %q", i+1, entry.Username)
+		}
+		seen[strings.ToLower(entry.Username)] = true
+
+		if entry.Role == "" {
+			continue
+		}
+		if _, ok := types.ParseUserRole(entry.Role); !ok {
+			return nil, fmt.Errorf("entry %d: invalid role %q for %s", i+1, entry.Role, entry.Username)
+		}
+	}
+
+	return entries, nil
+}
+
+func parseUserImportCSV(r io.Reader) ([]userImportEntry, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse CSV: %w", err)
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("CSV file is empty")
+	}
+
+	columns := map[string]int{}
+	for i, name := range records[0] {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	if _, ok := columns["username"]; !ok {
+		return nil, fmt.Errorf("CSV header must contain a username column")
+	}
+
+	field := func(record []string, name string) string {
+		index, ok := columns[name]
+		if !ok || index >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[index])
+	}
+
+	entries := make([]userImportEntry, 0, len(records)-1)
+	for _, record := range records[1:] {
+		entry := userImportEntry{
+			Username: field(record, "username"),
+			Role:     field(record, "role"),
+		}
+		for _, team := range strings.Split(field(record, "teams"), ";") {
+			if team = strings.TrimSpace(team); team != "" {
+				entry.Teams = append(entry.Teams, team)
+			}
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+func parseUserImportYAML(data []byte) ([]userImportEntry, error) {
+	var file userImportFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse YAML: %w", err)
+	}
+
+	return file.Users, nil
+}
+
+func importUsers(ctx context.Context, cl *client.Client, directory *accessDirectory, entries []userImportEntry, dryRun bool) (map[string]string, error) {
+	prefix := ""
+	if dryRun {
+		prefix = "[dry-run] "
+	}
+
+	passwords := map[string]string{}
+	teams := map[string]*types.Team{}
+
+	ensureTeam := func(name string) (*types.Team, error) {
+		key := strings.ToLower(name)
+		if team, ok := teams[key]; ok {
+			return team, nil
+		}
+
+		team := directory.findTeamByName(name)
+		if team == nil {
+			fmt.Printf("%sTeam '%s': created\n", prefix, name)
+			team = &types.Team{Name: name}
+			if !dryRun {
+				created, err := cl.CreateTeam(ctx, types.TeamCreateRequest{Name: name})
+				if err != nil {
+					return nil, apiError(err, "create team "+name, "team")
+				}
+				team = created
+			}
+		}
+
+		teams[key] = team
+		return team, nil
+	}
+
+	for _, entry := range entries {
+		// Without a role in the file new users are standard users and the role
+		// of existing users is left as it is
+		role, hasRole := types.ParseUserRole(entry.Role)
+		if !hasRole {
+			role = types.UserRoleStandard
+		}
+
+		user := directory.findUserByName(entry.Username)
+		switch {
+		case user == nil:
+			fmt.Printf("%sUser '%s': created (role: %s)\n", prefix, entry.Username, role)
+			user = &types.User{Username: entry.Username, Role: role}
+			if !dryRun {
+				password, err := generatePassword()
+				if err != nil {
+					return passwords, err
+				}
+
+				created, err := cl.CreateUser(ctx, types.UserCreateRequest{Username: entry.Username, Password: password, Role: role})
+				if err != nil {
+					return passwords, apiError(err, "create user "+entry.Username, "user")
+				}
+				user = created
+				passwords[entry.Username] = password
+			}
+		case hasRole && user.Role != role:
+			fmt.Printf("%sUser '%s': role changed from %s to %s\n", prefix, entry.Username, user.Role, role)
+			if !dryRun {
+				if _, err := cl.UpdateUser(ctx, user.ID, types.UserUpdateRequest{Role: role}); err != nil {
+					return passwords, apiError(err, "update user "+entry.Username, "user")
+				}
+			}
+		default:
+			fmt.Printf("%sUser '%s': unchanged\n", prefix, entry.Username)
+		}
+
+		for _, name := range entry.Teams {
+			team, err := ensureTeam(name)
+			if err != nil {
+				return passwords, err
+			}
+
+			if user.ID != 0 && team.ID != 0 && directory.findMembership(user.ID, team.ID) != nil {
+				continue
+			}
+
+			fmt.Printf("%sUser '%s': added to team '%s'\n", prefix, entry.Username, name)
+			if dryRun {
+				continue
+			}
+
+			_, err = cl.CreateTeamMembership(ctx, types.TeamMembershipCreateRequest{
+				UserID: user.ID,
+				TeamID: team.ID,
+				Role:   types.MembershipRoleMember,
+			})
+			if err != nil {
+				return passwords, apiError(err, "add "+entry.Username+" to team "+name, "team")
+			}
+		}
+	}
+
+	return passwords, nil
+}
+
+func init() {
+	usersImportCmd.Flags().BoolVar(&usersImportDryRun, "dry-run", false, "Show the changes without applying them")
+}
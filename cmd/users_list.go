@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/internal/printer"
+	"github.com/spf13/cobra"
+)
+
+var usersListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List users",
+	Long: `List Portainer users with their role and teams.
+
+Examples:
+  # List users
+  portainer users list
+
+  # Output in JSON format
+  portainer users list --output json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, _, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		directory, err := loadAccessDirectory(cmd.Context(), cl)
+		if err != nil {
+			return err
+		}
+
+		users := directory.userDetails()
+		if len(users) == 0 {
+			fmt.Println("No users found.")
+			return nil
+		}
+
+		outputFormat := cmd.Flag("output").Value.String()
+
+		return printer.PrintUsers(users, outputFormat)
+	},
+}
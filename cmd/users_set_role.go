@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var usersSetRoleCmd = &cobra.Command{
+	Use:   "set-role [user] [role]",
+	Short: "Change the role of a user",
+	Long: `Change the role of a user referenced by username or ID. Roles are admin and standard.
+
+Examples:
+  # Promote a user to administrator
+  portainer users set-role alice admin`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		role, ok := types.ParseUserRole(args[1])
+		if !ok {
+			return fmt.Errorf("invalid role %q. Use admin or standard", args[1])
+		}
+
+		cl, _, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		directory, err := loadAccessDirectory(cmd.Context(), cl)
+		if err != nil {
+			return err
+		}
+
+		user := directory.findUser(args[0])
+		if user == nil {
+			return fmt.Errorf("user %q not found", args[0])
+		}
+
+		if user.Role == role {
+			fmt.Printf("User '%s' already has role %s\n", user.Username, role)
+			return nil
+		}
+
+		if _, err := cl.UpdateUser(cmd.Context(), user.ID, types.UserUpdateRequest{Role: role}); err != nil {
+			return apiError(err, "update user", "user")
+		}
+
+		fmt.Printf("User '%s' role set to %s\n", user.Username, role)
+		return nil
+	},
+}
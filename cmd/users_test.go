@@ -0,0 +1,213 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pdrhp/portainer-go-cli/internal/client"
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseUserImportCSV(t *testing.T) {
+	entries, err := parseUserImportCSV(strings.NewReader("username, role, teams\nalice,standard,backend; sre\nbob,admin,\ncarol\n"))
+
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	assert.Equal(t, userImportEntry{Username: "alice", Role: "standard", Teams: []string{"backend", "sre"}}, entries[0])
+	assert.Equal(t, userImportEntry{Username: "bob", Role: "admin"}, entries[1])
+	assert.Equal(t, userImportEntry{Username: "carol"}, entries[2])
+
+	_, err = parseUserImportCSV(strings.NewReader("name,role\nalice,admin\n"))
+	assert.Error(t, err)
+}
+
+func TestReadUserImportFile_YAML(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "users.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`users:
+  - username: alice
+    teams: [backend]
+  - username: bob
+    role: admin
+`), 0600))
+
+	entries, err := readUserImportFile(path)
+
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Empty(t, entries[0].Role)
+	assert.Equal(t, []string{"backend"}, entries[0].Teams)
+	assert.Equal(t, "admin", entries[1].Role)
+}
+
+func TestReadUserImportFile_Invalid(t *testing.T) {
+	dir := t.TempDir()
+
+	duplicate := filepath.Join(dir, "duplicate.csv")
+	require.NoError(t, os.WriteFile(duplicate, []byte("username\nalice\nalice\n"), 0600))
+	_, err := readUserImportFile(duplicate)
+	assert.EqualError(t, err, `entry 2: duplicate username "alice"`)
+
+	caseDuplicate := filepath.Join(dir, "case.csv")
+	require.NoError(t, os.WriteFile(caseDuplicate, []byte("username\nalice\nAlice\n"), 0600))
+	_, err = readUserImportFile(caseDuplicate)
+	assert.EqualError(t, err, `entry 2: duplicate username "Alice"`)
+
+	role := filepath.Join(dir, "role.csv")
+	require.NoError(t, os.WriteFile(role, []byte("username,role\nalice,owner\n"), 0600))
+	_, err = readUserImportFile(role)
+	assert.EqualError(t, err, `entry 1: invalid role "owner" for alice`)
+
+	_, err = readUserImportFile(filepath.Join(dir, "users.json"))
+	assert.Error(t, err)
+}
+
+func TestImportUsers_Idempotent(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method + " " + r.URL.Path {
+		case "POST /api/users":
+			var request types.UserCreateRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+			assert.Equal(t, "carol", request.Username)
+			assert.Equal(t, types.UserRoleStandard, request.Role)
+			assert.Len(t, request.Password, generatedPasswordLength)
+			w.Write([]byte(`{"Id":3,"Username":"carol","Role":2}`))
+		case "PUT /api/users/2":
+			w.Write([]byte(`{"Id":2,"Username":"bob","Role":1}`))
+		case "POST /api/teams":
+			w.Write([]byte(`{"Id":11,"Name":"sre"}`))
+		case "POST /api/team_memberships":
+			var request types.TeamMembershipCreateRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+			w.Write([]byte(`{"Id":99}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	cl := client.New(server.URL)
+	cl.SetToken("test-token")
+
+	directory := &accessDirectory{
+		users: []types.User{
+			{ID: 1, Username: "alice", Role: types.UserRoleStandard},
+			{ID: 2, Username: "bob", Role: types.UserRoleStandard},
+			{ID: 4, Username: "dave", Role: types.UserRoleAdmin},
+		},
+		teams:       []types.Team{{ID: 10, Name: "backend"}},
+		memberships: []types.TeamMembership{{ID: 5, UserID: 1, TeamID: 10, Role: types.MembershipRoleMember}},
+	}
+
+	entries := []userImportEntry{
+		{Username: "alice", Role: "standard", Teams: []string{"backend"}},
+		{Username: "bob", Role: "admin"},
+		{Username: "carol", Teams: []string{"backend", "sre"}},
+		// Without a role in the file an existing administrator keeps the role
+		{Username: "dave"},
+	}
+
+	passwords, err := importUsers(context.Background(), cl, directory, entries, false)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"PUT /api/users/2",
+		"POST /api/users",
+		"POST /api/team_memberships",
+		"POST /api/teams",
+		"POST /api/team_memberships",
+	}, requests)
+	assert.Contains(t, passwords, "carol")
+	assert.NotContains(t, passwords, "alice")
+}
+
+func TestImportUsers_MatchesNamesOnly(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method + " " + r.URL.Path {
+		case "POST /api/users":
+			var request types.UserCreateRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+			assert.Equal(t, "1001", request.Username)
+			w.Write([]byte(`{"Id":7,"Username":"1001","Role":2}`))
+		case "POST /api/teams":
+			var request types.TeamCreateRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+			assert.Equal(t, "10", request.Name)
+			w.Write([]byte(`{"Id":12,"Name":"10"}`))
+		case "POST /api/team_memberships":
+			w.Write([]byte(`{"Id":99}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	cl := client.New(server.URL)
+	cl.SetToken("test-token")
+
+	directory := &accessDirectory{
+		users: []types.User{
+			{ID: 1001, Username: "alice", Role: types.UserRoleStandard},
+			{ID: 2, Username: "Bob", Role: types.UserRoleStandard},
+		},
+		teams:       []types.Team{{ID: 10, Name: "Backend"}},
+		memberships: []types.TeamMembership{{ID: 5, UserID: 2, TeamID: 10, Role: types.MembershipRoleMember}},
+	}
+
+	passwords, err := importUsers(context.Background(), cl, directory, []userImportEntry{
+		// A numeric username is a name, not the ID of alice
+		{Username: "1001", Teams: []string{"10"}},
+		// Names differing only in case are the existing user and team
+		{Username: "bob", Teams: []string{"backend"}},
+	}, false)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"POST /api/users",
+		"POST /api/teams",
+		"POST /api/team_memberships",
+	}, requests)
+	assert.Contains(t, passwords, "1001")
+}
+
+func TestImportUsers_DryRunSendsNothing(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+	}))
+	defer server.Close()
+
+	cl := client.New(server.URL)
+	cl.SetToken("test-token")
+
+	passwords, err := importUsers(context.Background(), cl, &accessDirectory{}, []userImportEntry{
+		{Username: "alice", Role: "standard", Teams: []string{"backend"}},
+	}, true)
+
+	require.NoError(t, err)
+	assert.Empty(t, passwords)
+}
+
+func TestGeneratePassword(t *testing.T) {
+	first, err := generatePassword()
+	require.NoError(t, err)
+	second, err := generatePassword()
+	require.NoError(t, err)
+
+	assert.Len(t, first, generatedPasswordLength)
+	assert.NotEqual(t, first, second)
+}
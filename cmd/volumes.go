@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/pdrhp/portainer-go-cli/internal/client"
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var volumesNode string
+
+var volumesCmd = &cobra.Command{
+	Use:   "volumes",
+	Short: "Manage volumes",
+	Long:  `Manage Docker volumes of an environment through the Portainer Docker proxy`,
+}
+
+func init() {
+	volumesCmd.PersistentFlags().StringVar(&volumesNode, "node", "", "Target a specific swarm node (agent environments only)")
+
+	volumesCmd.AddCommand(volumesListCmd)
+	volumesCmd.AddCommand(volumesInspectCmd)
+	volumesCmd.AddCommand(volumesCreateCmd)
+	volumesCmd.AddCommand(volumesRmCmd)
+	volumesCmd.AddCommand(volumesPruneCmd)
+}
+
+func listVolumeDetails(ctx context.Context, cl *client.Client, endpointID int, stack string, unusedOnly bool) ([]types.VolumeDetails, error) {
+	unused, err := cl.ListVolumes(ctx, endpointID, &types.VolumeFilters{Stack: stack, Dangling: true})
+	if err != nil {
+		return nil, err
+	}
+
+	volumes := unused
+	if !unusedOnly {
+		volumes, err = cl.ListVolumes(ctx, endpointID, &types.VolumeFilters{Stack: stack})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Local volumes of different swarm nodes can share a name
+	unusedKeys := map[string]bool{}
+	for _, volume := range unused {
+		unusedKeys[volume.NodeName()+"/"+volume.Name] = true
+	}
+
+	details := make([]types.VolumeDetails, 0, len(volumes))
+	for _, volume := range volumes {
+		details = append(details, types.VolumeDetails{
+			Volume: volume,
+			Unused: unusedKeys[volume.NodeName()+"/"+volume.Name],
+		})
+	}
+
+	return details, nil
+}
@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var (
+	volumesCreateDriver string
+	volumesCreateLabels []string
+	volumesCreateOpts   []string
+)
+
+var volumesCreateCmd = &cobra.Command{
+	Use:   "create [name]",
+	Short: "Create a volume",
+	Long: `Create a volume on an environment.
+
+Examples:
+  # Create a local volume
+  portainer volumes create backups
+
+  # Create a labelled NFS volume on a specific node
+  portainer volumes create media --node worker-2 --label team=media --opt type=nfs --opt o=addr=10.0.0.5,rw --opt device=:/exports/media`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		labels, err := parseKeyValueFlags(volumesCreateLabels, "label")
+		if err != nil {
+			return err
+		}
+
+		opts, err := parseKeyValueFlags(volumesCreateOpts, "opt")
+		if err != nil {
+			return err
+		}
+
+		cl, endpointID, err := newNodeClient(cmd, volumesNode)
+		if err != nil {
+			return err
+		}
+
+		volume, err := cl.CreateVolume(cmd.Context(), endpointID, types.VolumeCreateRequest{
+			Name:       args[0],
+			Driver:     volumesCreateDriver,
+			DriverOpts: opts,
+			Labels:     labels,
+		})
+		if err != nil {
+			return apiError(err, "create volume", "endpoint")
+		}
+
+		fmt.Printf("Volume %s created successfully (driver: %s)\n", volume.Name, volume.Driver)
+		return nil
+	},
+}
+
+func init() {
+	volumesCreateCmd.Flags().StringVar(&volumesCreateDriver, "driver", "", "Volume driver (default: local)")
+	volumesCreateCmd.Flags().StringArrayVar(&volumesCreateLabels, "label", []string{}, "Volume label (format: KEY=value, can be used multiple times)")
+	volumesCreateCmd.Flags().StringArrayVar(&volumesCreateOpts, "opt", []string{}, "Driver option (format: KEY=value, can be used multiple times)")
+}
@@ -0,0 +1,35 @@
+package cmd
+
+import (
+	"github.com/pdrhp/portainer-go-cli/internal/printer"
+	"github.com/spf13/cobra"
+)
+
+var volumesInspectCmd = &cobra.Command{
+	Use:   "inspect [volume]",
+	Short: "Show details of a volume",
+	Long: `Show details of a volume referenced by name.
+
+Examples:
+  # Inspect a volume
+  portainer volumes inspect web_data
+
+  # Inspect a volume local to a swarm node
+  portainer volumes inspect web_data --node worker-2 --output json`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, endpointID, err := newNodeClient(cmd, volumesNode)
+		if err != nil {
+			return err
+		}
+
+		volume, err := cl.InspectVolume(cmd.Context(), endpointID, args[0])
+		if err != nil {
+			return apiError(err, "inspect volume", "volume")
+		}
+
+		outputFormat := cmd.Flag("output").Value.String()
+
+		return printer.PrintVolume(*volume, outputFormat)
+	},
+}
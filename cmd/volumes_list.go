@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/internal/printer"
+	"github.com/spf13/cobra"
+)
+
+var (
+	volumesListStack  string
+	volumesListUnused bool
+)
+
+var volumesListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List volumes",
+	Long: `List volumes of an environment with the stack they belong to and whether a container uses them.
+
+The stack is read from the Swarm (com.docker.stack.namespace) or Compose
+(com.docker.compose.project) labels.
+
+Examples:
+  # List volumes of the default endpoint
+  portainer volumes list
+
+  # List volumes left behind by a removed stack
+  portainer volumes list --stack web --unused
+
+  # Output in JSON format
+  portainer volumes list --output json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, endpointID, err := newNodeClient(cmd, volumesNode)
+		if err != nil {
+			return err
+		}
+
+		volumes, err := listVolumeDetails(cmd.Context(), cl, endpointID, volumesListStack, volumesListUnused)
+		if err != nil {
+			return apiError(err, "list volumes", "endpoint")
+		}
+
+		if len(volumes) == 0 {
+			fmt.Println("No volumes found.")
+			return nil
+		}
+
+		outputFormat := cmd.Flag("output").Value.String()
+
+		return printer.PrintVolumes(volumes, outputFormat)
+	},
+}
+
+func init() {
+	volumesListCmd.Flags().StringVar(&volumesListStack, "stack", "", "Filter volumes by stack name")
+	volumesListCmd.Flags().BoolVar(&volumesListUnused, "unused", false, "Show only volumes not used by any container")
+}
@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/internal/printer"
+	"github.com/spf13/cobra"
+)
+
+var (
+	volumesPruneStack  string
+	volumesPruneDryRun bool
+)
+
+var volumesPruneCmd = &cobra.Command{
+	Use:   "prune",
+	Short: "Remove unused volumes",
+	Long: `Remove every volume, named or anonymous, that is not used by a container.
+
+Volumes are removed one by one so that --stack can be applied and so that, on
+agent environments, each volume is removed on the node holding it.
+
+Examples:
+  # Preview the volumes left behind by a removed stack
+  portainer volumes prune --stack web --dry-run
+
+  # Remove the unused volumes of a stack
+  portainer volumes prune --stack web
+
+  # Remove all unused volumes of a swarm node
+  portainer volumes prune --node worker-2`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, endpointID, err := newNodeClient(cmd, volumesNode)
+		if err != nil {
+			return err
+		}
+
+		candidates, err := listVolumeDetails(cmd.Context(), cl, endpointID, volumesPruneStack, true)
+		if err != nil {
+			return apiError(err, "list volumes", "endpoint")
+		}
+
+		if len(candidates) == 0 {
+			fmt.Println("No unused volumes found.")
+			return nil
+		}
+
+		if volumesPruneDryRun {
+			outputFormat := cmd.Flag("output").Value.String()
+			if err := printer.PrintVolumes(candidates, outputFormat); err != nil {
+				return err
+			}
+
+			if outputFormat != "json" && outputFormat != "yaml" {
+				fmt.Printf("\nWould remove %d volumes\n", len(candidates))
+			}
+			return nil
+		}
+
+		failed := 0
+		for _, volume := range candidates {
+			target := cl
+			if node := volume.NodeName(); node != "" {
+				target = cl.ForNode(node)
+			}
+
+			if err := target.RemoveVolume(cmd.Context(), endpointID, volume.Name, false); err != nil {
+				fmt.Printf("Error: %s: %v\n", volume.Name, apiError(err, "remove volume", "volume"))
+				failed++
+				continue
+			}
+			fmt.Printf("Deleted: %s\n", volume.Name)
+		}
+
+		if failed > 0 {
+			return fmt.Errorf("failed to remove %d of %d volumes", failed, len(candidates))
+		}
+
+		fmt.Printf("Removed %d volumes\n", len(candidates))
+		return nil
+	},
+}
+
+func init() {
+	volumesPruneCmd.Flags().StringVar(&volumesPruneStack, "stack", "", "Only remove volumes of this stack")
+	volumesPruneCmd.Flags().BoolVar(&volumesPruneDryRun, "dry-run", false, "Show the volumes that would be removed without removing them")
+}
@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var volumesRmForce bool
+
+var volumesRmCmd = &cobra.Command{
+	Use:     "rm [volume...]",
+	Aliases: []string{"remove"},
+	Short:   "Remove one or more volumes",
+	Long: `Remove one or more volumes referenced by name.
+
+Examples:
+  # Remove volumes
+  portainer volumes rm web_data web_cache
+
+  # Remove a volume local to a swarm node
+  portainer volumes rm web_data --node worker-2`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, endpointID, err := newNodeClient(cmd, volumesNode)
+		if err != nil {
+			return err
+		}
+
+		failed := 0
+		for _, name := range args {
+			if err := cl.RemoveVolume(cmd.Context(), endpointID, name, volumesRmForce); err != nil {
+				fmt.Printf("Error: %s: %v\n", name, apiError(err, "remove volume", "volume"))
+				failed++
+				continue
+			}
+			fmt.Printf("Removed %s\n", name)
+		}
+
+		if failed > 0 {
+			return fmt.Errorf("failed to remove %d of %d volumes", failed, len(args))
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	volumesRmCmd.Flags().BoolVarP(&volumesRmForce, "force", "f", false, "Force the removal of the volume")
+}
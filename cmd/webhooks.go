@@ -0,0 +1,361 @@
+package cmd
+
+import (
+	"crypto/rand"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/pdrhp/portainer-go-cli/internal/client"
+	"github.com/pdrhp/portainer-go-cli/internal/config"
+	"github.com/pdrhp/portainer-go-cli/internal/printer"
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	stackWebhookPath   = "/api/stacks/webhooks/"
+	serviceWebhookPath = "/api/webhooks/"
+)
+
+var (
+	webhooksCreateService   string
+	webhooksCreateContainer string
+	webhooksCreateRegistry  int
+	webhooksTriggerStack    bool
+	webhooksTriggerTag      string
+)
+
+var webhooksCmd = &cobra.Command{
+	Use:   "webhooks",
+	Short: "Manage webhooks",
+	Long:  `Manage the webhooks that update services and containers, and trigger stack and service webhooks`,
+}
+
+func init() {
+	webhooksCmd.AddCommand(webhooksListCmd)
+	webhooksCmd.AddCommand(webhooksCreateCmd)
+	webhooksCmd.AddCommand(webhooksDeleteCmd)
+	webhooksCmd.AddCommand(webhooksTriggerCmd)
+
+	webhooksCreateCmd.Flags().StringVar(&webhooksCreateService, "service", "", "Service to update when the webhook is triggered, by name or ID")
+	webhooksCreateCmd.Flags().StringVar(&webhooksCreateContainer, "container", "", "Container to recreate when the webhook is triggered, by name or ID")
+	webhooksCreateCmd.Flags().IntVar(&webhooksCreateRegistry, "registry", 0, "ID of the registry used to pull the image")
+
+	webhooksTriggerCmd.Flags().BoolVar(&webhooksTriggerStack, "stack", false, "Treat the argument as a stack webhook ID")
+	webhooksTriggerCmd.Flags().StringVar(&webhooksTriggerTag, "tag", "", "Image tag to deploy (service and container webhooks only)")
+}
+
+func generateWebhookID() (string, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", fmt.Errorf("failed to generate webhook ID: %w", err)
+	}
+
+	// Random UUID, version 4 and RFC 4122 variant
+	id[6] = (id[6] & 0x0f) | 0x40
+	id[8] = (id[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", id[0:4], id[4:6], id[6:8], id[8:10], id[10:]), nil
+}
+
+func resolveAutoUpdateWebhook(settings *types.AutoUpdateSettings) error {
+	if settings == nil || !strings.EqualFold(settings.Webhook, "auto") {
+		return nil
+	}
+
+	id, err := generateWebhookID()
+	if err != nil {
+		return err
+	}
+	settings.Webhook = id
+
+	return nil
+}
+
+func stackWebhookURL(baseURL string, webhookID string) string {
+	return strings.TrimSuffix(baseURL, "/") + stackWebhookPath + webhookID
+}
+
+func serviceWebhookURL(baseURL string, token string) string {
+	return strings.TrimSuffix(baseURL, "/") + serviceWebhookPath + token
+}
+
+func printStackWebhookURL(cl *client.Client, settings *types.AutoUpdateSettings) {
+	if settings != nil && settings.Webhook != "" {
+		fmt.Printf("Webhook URL: %s\n", stackWebhookURL(cl.BaseURL(), settings.Webhook))
+	}
+}
+
+func parseWebhookRef(ref string) (baseURL string, stack bool, id string, err error) {
+	// A full webhook URL also carries the server to call and whether it is a stack webhook
+	if !strings.HasPrefix(ref, "http://") && !strings.HasPrefix(ref, "https://") {
+		return "", false, ref, nil
+	}
+
+	u, err := url.Parse(ref)
+	if err != nil {
+		return "", false, "", fmt.Errorf("invalid webhook URL %q: %w", ref, err)
+	}
+
+	for _, path := range []string{stackWebhookPath, serviceWebhookPath} {
+		prefix, rest, found := strings.Cut(u.Path, path)
+		if !found || rest == "" || strings.Contains(rest, "/") {
+			continue
+		}
+		baseURL = u.Scheme + "://" + u.Host + prefix
+		return baseURL, path == stackWebhookPath, rest, nil
+	}
+
+	return "", false, "", fmt.Errorf("invalid webhook URL %q, expected .../api/stacks/webhooks/ID or .../api/webhooks/TOKEN", ref)
+}
+
+func newWebhookClient(cmd *cobra.Command, baseURL string) (*client.Client, error) {
+	// Webhooks are triggered without credentials, only the server URL is needed
+	if baseURL == "" {
+		baseURL = cmd.Flag("server-url").Value.String()
+	}
+	if baseURL == "" {
+		cfg, err := config.Load()
+		if err != nil {
+			return nil, fmt.Errorf("failed to load config: %w", err)
+		}
+		baseURL = cfg.ServerURL
+	}
+	if baseURL == "" {
+		return nil, fmt.Errorf("server URL not configured. Pass the full webhook URL, use --server-url or set it in config")
+	}
+
+	return client.New(baseURL), nil
+}
+
+func findWebhook(webhooks []types.Webhook, ref string) *types.Webhook {
+	id, _ := strconv.Atoi(ref)
+	for i := range webhooks {
+		if webhooks[i].Token == ref || (id > 0 && webhooks[i].ID == id) {
+			return &webhooks[i]
+		}
+	}
+	return nil
+}
+
+func webhookDetails(baseURL string, webhooks []types.Webhook, stacks []types.Stack) []types.WebhookDetails {
+	details := make([]types.WebhookDetails, 0, len(webhooks)+len(stacks))
+	for _, stack := range stacks {
+		if stack.AutoUpdate == nil || stack.AutoUpdate.Webhook == "" {
+			continue
+		}
+		details = append(details, types.WebhookDetails{
+			Type:       "stack",
+			Token:      stack.AutoUpdate.Webhook,
+			Resource:   stack.Name,
+			EndpointID: stack.EndpointID,
+			URL:        stackWebhookURL(baseURL, stack.AutoUpdate.Webhook),
+		})
+	}
+
+	for _, webhook := range webhooks {
+		details = append(details, types.WebhookDetails{
+			Type:       webhook.Type.String(),
+			ID:         webhook.ID,
+			Token:      webhook.Token,
+			Resource:   webhook.ResourceID,
+			EndpointID: webhook.EndpointID,
+			URL:        serviceWebhookURL(baseURL, webhook.Token),
+		})
+	}
+
+	return details
+}
+
+var webhooksListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List webhooks",
+	Long: `List stack auto-update webhooks and service and container webhooks, with their trigger URL.
+
+Examples:
+  # List every webhook
+  portainer webhooks list
+
+  # List webhooks of an environment
+  portainer webhooks list --endpoint prod-swarm --output json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, cfg, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		endpointID, err := resolveEndpointID(cmd, cl, cfg)
+		if err != nil {
+			return err
+		}
+
+		webhooks, err := cl.ListWebhooks(cmd.Context(), &types.WebhookFilters{EndpointID: endpointID})
+		if err != nil {
+			return apiError(err, "list webhooks", "webhook")
+		}
+
+		stacks, err := cl.ListStacks(cmd.Context(), &types.StackFilters{EndpointID: endpointID})
+		if err != nil {
+			return apiError(err, "list stacks", "stack")
+		}
+
+		details := webhookDetails(cl.BaseURL(), webhooks, stacks)
+		if len(details) == 0 {
+			fmt.Println("No webhooks found.")
+			return nil
+		}
+
+		outputFormat := cmd.Flag("output").Value.String()
+
+		return printer.PrintWebhooks(details, outputFormat)
+	},
+}
+
+var webhooksCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a service or container webhook",
+	Long: `Create a webhook that updates a swarm service or recreates a container when triggered.
+
+Stack webhooks are created with --auto-update-webhook on stack creation.
+
+Examples:
+  # Create a webhook for a service
+  portainer webhooks create --service shop_api --endpoint prod-swarm
+
+  # Create a webhook for a container pulling from a private registry
+  portainer webhooks create --container worker --registry 2`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if (webhooksCreateService == "") == (webhooksCreateContainer == "") {
+			return fmt.Errorf("use exactly one of --service or --container")
+		}
+
+		cl, cfg, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		endpointID, err := requireEndpointID(cmd, cl, cfg)
+		if err != nil {
+			return err
+		}
+
+		request := types.WebhookCreateRequest{EndpointID: endpointID, RegistryID: webhooksCreateRegistry}
+		if webhooksCreateService != "" {
+			service, err := cl.InspectService(cmd.Context(), endpointID, webhooksCreateService)
+			if err != nil {
+				return apiError(err, "inspect service", "service")
+			}
+			request.ResourceID = service.ID
+			request.WebhookType = types.WebhookTypeService
+		} else {
+			container, err := cl.InspectContainer(cmd.Context(), endpointID, webhooksCreateContainer)
+			if err != nil {
+				return apiError(err, "inspect container", "container")
+			}
+			request.ResourceID = container.ID
+			request.WebhookType = types.WebhookTypeContainer
+		}
+
+		webhook, err := cl.CreateWebhook(cmd.Context(), request)
+		if err != nil {
+			return apiError(err, "create webhook", "webhook")
+		}
+
+		fmt.Printf("Webhook created (ID: %d)\n", webhook.ID)
+		fmt.Printf("Webhook URL: %s\n", serviceWebhookURL(cl.BaseURL(), webhook.Token))
+		return nil
+	},
+}
+
+var webhooksDeleteCmd = &cobra.Command{
+	Use:     "delete [webhook...]",
+	Aliases: []string{"rm"},
+	Short:   "Delete one or more webhooks",
+	Long: `Delete one or more service or container webhooks referenced by ID or token.
+
+Examples:
+  # Delete webhooks
+  portainer webhooks delete 4 8b4c2f0e-6d1a-4f3b-9a57-2c1e0d9b7a61`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cl, _, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		webhooks, err := cl.ListWebhooks(cmd.Context(), nil)
+		if err != nil {
+			return apiError(err, "list webhooks", "webhook")
+		}
+
+		failed := 0
+		for _, ref := range args {
+			webhook := findWebhook(webhooks, ref)
+			if webhook == nil {
+				fmt.Printf("Error: %s: webhook not found\n", ref)
+				failed++
+				continue
+			}
+			if err := cl.DeleteWebhook(cmd.Context(), webhook.ID); err != nil {
+				fmt.Printf("Error: %s: %v\n", ref, apiError(err, "delete webhook", "webhook"))
+				failed++
+				continue
+			}
+			fmt.Printf("Deleted webhook %d\n", webhook.ID)
+		}
+
+		if failed > 0 {
+			return fmt.Errorf("failed to delete %d of %d webhooks", failed, len(args))
+		}
+
+		return nil
+	},
+}
+
+var webhooksTriggerCmd = &cobra.Command{
+	Use:   "trigger [token|url]",
+	Short: "Trigger a webhook",
+	Long: `Trigger a service, container or stack webhook. No authentication is needed.
+
+The webhook is referenced by its full URL, or by its token together with
+--server-url or the server URL from config. Use --stack for a bare stack
+webhook ID.
+
+Examples:
+  # Trigger a service webhook by URL, deploying another image tag
+  portainer webhooks trigger https://portainer.example.com/api/webhooks/8b4c2f0e-6d1a-4f3b-9a57-2c1e0d9b7a61 --tag 1.4.2
+
+  # Trigger a stack webhook by ID
+  portainer webhooks trigger 05de31a2-79fa-4644-9c12-faa67e5c49f0 --stack`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		baseURL, stack, id, err := parseWebhookRef(args[0])
+		if err != nil {
+			return err
+		}
+		stack = stack || webhooksTriggerStack
+
+		if stack && webhooksTriggerTag != "" {
+			return fmt.Errorf("--tag is not supported by stack webhooks")
+		}
+
+		cl, err := newWebhookClient(cmd, baseURL)
+		if err != nil {
+			return err
+		}
+
+		if stack {
+			err = cl.TriggerStackWebhook(cmd.Context(), id, nil)
+		} else {
+			err = cl.TriggerWebhook(cmd.Context(), id, webhooksTriggerTag)
+		}
+		if err != nil {
+			return apiError(err, "trigger webhook", "webhook")
+		}
+
+		fmt.Println("Webhook triggered")
+		return nil
+	},
+}
@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveAutoUpdateWebhook(t *testing.T) {
+	settings := &types.AutoUpdateSettings{Interval: "5m", Webhook: "auto"}
+
+	require.NoError(t, resolveAutoUpdateWebhook(settings))
+	assert.Regexp(t, regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`), settings.Webhook)
+
+	explicit := &types.AutoUpdateSettings{Webhook: "05de31a2-79fa-4644-9c12-faa67e5c49f0"}
+	require.NoError(t, resolveAutoUpdateWebhook(explicit))
+	assert.Equal(t, "05de31a2-79fa-4644-9c12-faa67e5c49f0", explicit.Webhook)
+
+	assert.NoError(t, resolveAutoUpdateWebhook(nil))
+}
+
+func TestParseWebhookRef(t *testing.T) {
+	tests := []struct {
+		name    string
+		ref     string
+		baseURL string
+		stack   bool
+		id      string
+		wantErr bool
+	}{
+		{name: "bare id", ref: "abc", id: "abc"},
+		{name: "stack url", ref: "https://portainer.example.com/api/stacks/webhooks/abc", baseURL: "https://portainer.example.com", stack: true, id: "abc"},
+		{name: "service url behind a path prefix", ref: "https://example.com/portainer/api/webhooks/tok", baseURL: "https://example.com/portainer", id: "tok"},
+		{name: "unknown path", ref: "https://portainer.example.com/api/stacks/12", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			baseURL, stack, id, err := parseWebhookRef(tt.ref)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.baseURL, baseURL)
+			assert.Equal(t, tt.stack, stack)
+			assert.Equal(t, tt.id, id)
+		})
+	}
+}
+
+func TestWebhookDetails(t *testing.T) {
+	webhooks := []types.Webhook{{ID: 4, Token: "tok", ResourceID: "svc1", EndpointID: 1, Type: types.WebhookTypeService}}
+	stacks := []types.Stack{
+		{Name: "shop", EndpointID: 1, AutoUpdate: &types.StackAutoUpdate{Webhook: "abc"}},
+		{Name: "blog", EndpointID: 1, AutoUpdate: &types.StackAutoUpdate{Interval: "5m"}},
+		{Name: "docs", EndpointID: 2},
+	}
+
+	details := webhookDetails("https://portainer.example.com/", webhooks, stacks)
+
+	assert.Equal(t, []types.WebhookDetails{
+		{Type: "stack", Token: "abc", Resource: "shop", EndpointID: 1, URL: "https://portainer.example.com/api/stacks/webhooks/abc"},
+		{Type: "service", ID: 4, Token: "tok", Resource: "svc1", EndpointID: 1, URL: "https://portainer.example.com/api/webhooks/tok"},
+	}, details)
+}
@@ -0,0 +1,18 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+)
+
+func (c *Client) ListAppTemplates(ctx context.Context) ([]types.AppTemplate, error) {
+	var list types.AppTemplateList
+	err := c.doRequest(ctx, "GET", "/api/templates", nil, &list)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list app templates: %w", err)
+	}
+
+	return list.Templates, nil
+}
@@ -0,0 +1,62 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_ListAppTemplates(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/templates", r.URL.Path)
+		w.Write([]byte(`{"version":"3","templates":[{"id":3,"type":3,"title":"Wordpress","categories":["CMS"],"repository":{"url":"https://github.com/portainer/templates","stackfile":"stacks/wordpress/docker-compose.yml"},"env":[{"name":"MYSQL_DATABASE_PASSWORD","label":"Database root password"}]}]}`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	templates, err := client.ListAppTemplates(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, templates, 1)
+	assert.Equal(t, types.AppTemplateTypeCompose, templates[0].Type)
+	assert.Equal(t, "stacks/wordpress/docker-compose.yml", templates[0].Repository.StackFile)
+	assert.Equal(t, "MYSQL_DATABASE_PASSWORD", templates[0].Env[0].Name)
+}
+
+func TestClient_CreateComposeStackFromGit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/api/stacks/create/standalone/repository", r.URL.Path)
+		assert.Equal(t, "2", r.URL.Query().Get("endpointId"))
+
+		var req map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "blog", req["name"])
+		assert.Equal(t, true, req["fromAppTemplate"])
+
+		w.Write([]byte(`{"Id":9,"Name":"blog","Type":2,"FromAppTemplate":true}`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	stack, err := client.CreateComposeStackFromGit(context.Background(), 2, types.StackCreateComposeGitPayload{
+		Name:            "blog",
+		RepositoryURL:   "https://github.com/portainer/templates",
+		ComposeFile:     "stacks/wordpress/docker-compose.yml",
+		FromAppTemplate: true,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 9, stack.ID)
+	assert.True(t, stack.FromAppTemplate)
+}
@@ -0,0 +1,121 @@
+package client
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+)
+
+type byteCounter int64
+
+func (b *byteCounter) Write(p []byte) (int, error) {
+	*b += byteCounter(len(p))
+	return len(p), nil
+}
+
+func (c *Client) CreateBackup(ctx context.Context, request types.BackupRequest, w io.Writer) (*types.BackupArchive, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request body: %w", err)
+	}
+
+	headers := map[string]string{"Content-Type": "application/json"}
+	resp, err := c.doStreamRequest(ctx, "POST", "/api/backup", headers, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create backup: %w", err)
+	}
+	defer resp.Body.Close()
+
+	hash := sha256.New()
+	var size byteCounter
+	tee := io.TeeReader(resp.Body, io.MultiWriter(w, hash, &size))
+
+	// An encrypted archive is opaque, a plain one is checked to be a complete
+	// gzip stream while it is written
+	if request.Password == "" {
+		gz, err := gzip.NewReader(tee)
+		if err != nil {
+			return nil, fmt.Errorf("backup archive is not a valid gzip stream: %w", err)
+		}
+		if _, err := io.Copy(io.Discard, gz); err != nil {
+			return nil, fmt.Errorf("backup archive is corrupted: %w", err)
+		}
+	}
+	if _, err := io.Copy(io.Discard, tee); err != nil {
+		return nil, fmt.Errorf("failed to download backup: %w", err)
+	}
+
+	if resp.ContentLength >= 0 && int64(size) != resp.ContentLength {
+		return nil, fmt.Errorf("backup archive truncated: received %d of %d bytes", size, resp.ContentLength)
+	}
+
+	archive := &types.BackupArchive{
+		Size:      int64(size),
+		SHA256:    hex.EncodeToString(hash.Sum(nil)),
+		Encrypted: request.Password != "",
+	}
+	if _, params, err := mime.ParseMediaType(resp.Header.Get("Content-Disposition")); err == nil {
+		archive.Filename = params["filename"]
+	}
+
+	return archive, nil
+}
+
+func (c *Client) RestoreBackup(ctx context.Context, archive io.Reader, filename string, password string) error {
+	pr, pw := io.Pipe()
+	writer := multipart.NewWriter(pw)
+
+	go func() {
+		part, err := writer.CreateFormFile("file", filename)
+		if err == nil {
+			_, err = io.Copy(part, archive)
+		}
+		if err == nil && password != "" {
+			err = writer.WriteField("password", password)
+		}
+		if err == nil {
+			err = writer.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+
+	headers := map[string]string{"Content-Type": writer.FormDataContentType()}
+	resp, err := c.doStreamRequest(ctx, "POST", "/api/restore", headers, pr)
+	// Unblocks the upload goroutine when the server answered early
+	pr.Close()
+	if err != nil {
+		return fmt.Errorf("failed to restore backup: %w", err)
+	}
+	resp.Body.Close()
+
+	return nil
+}
+
+func (c *Client) GetBackupS3Settings(ctx context.Context) (*types.BackupS3Settings, error) {
+	var settings types.BackupS3Settings
+	err := c.doRequest(ctx, "GET", "/api/backup/s3/settings", nil, &settings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get S3 backup settings: %w", err)
+	}
+
+	return &settings, nil
+}
+
+func (c *Client) GetBackupS3Status(ctx context.Context) (*types.BackupS3Status, error) {
+	var status types.BackupS3Status
+	err := c.doRequest(ctx, "GET", "/api/backup/s3/status", nil, &status)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get S3 backup status: %w", err)
+	}
+
+	return &status, nil
+}
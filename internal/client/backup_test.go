@@ -0,0 +1,133 @@
+package client
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func gzipData(t *testing.T, content string) []byte {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	_, err := gz.Write([]byte(content))
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func TestClient_CreateBackup(t *testing.T) {
+	archive := gzipData(t, "portainer.db")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/api/backup", r.URL.Path)
+
+		var req types.BackupRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Empty(t, req.Password)
+
+		w.Header().Set("Content-Disposition", `attachment; filename=portainer-backup_2026-10-19_02-00-00.tar.gz`)
+		w.Write(archive)
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	var out bytes.Buffer
+	result, err := client.CreateBackup(context.Background(), types.BackupRequest{}, &out)
+
+	require.NoError(t, err)
+	sum := sha256.Sum256(archive)
+	assert.Equal(t, archive, out.Bytes())
+	assert.Equal(t, hex.EncodeToString(sum[:]), result.SHA256)
+	assert.Equal(t, int64(len(archive)), result.Size)
+	assert.Equal(t, "portainer-backup_2026-10-19_02-00-00.tar.gz", result.Filename)
+	assert.False(t, result.Encrypted)
+}
+
+func TestClient_CreateBackupRejectsCorruptArchive(t *testing.T) {
+	archive := gzipData(t, strings.Repeat("portainer.db", 100))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(archive[:len(archive)/2])
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+
+	_, err := client.CreateBackup(context.Background(), types.BackupRequest{}, io.Discard)
+	assert.ErrorContains(t, err, "corrupted")
+}
+
+func TestClient_CreateBackupEncrypted(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req types.BackupRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "secret", req.Password)
+		w.Write([]byte("opaque encrypted data"))
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+
+	var out bytes.Buffer
+	result, err := client.CreateBackup(context.Background(), types.BackupRequest{Password: "secret"}, &out)
+
+	require.NoError(t, err)
+	assert.Equal(t, "opaque encrypted data", out.String())
+	assert.True(t, result.Encrypted)
+}
+
+func TestClient_RestoreBackup(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/api/restore", r.URL.Path)
+
+		require.NoError(t, r.ParseMultipartForm(1<<20))
+		assert.Equal(t, "secret", r.FormValue("password"))
+
+		file, header, err := r.FormFile("file")
+		require.NoError(t, err)
+		defer file.Close()
+		assert.Equal(t, "backup.tar.gz", header.Filename)
+		content, _ := io.ReadAll(file)
+		assert.Equal(t, "archive", string(content))
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+
+	err := client.RestoreBackup(context.Background(), strings.NewReader("archive"), "backup.tar.gz", "secret")
+	require.NoError(t, err)
+}
+
+func TestClient_GetBackupS3SettingsNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/backup/s3/settings", r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+
+	_, err := client.GetBackupS3Settings(context.Background())
+
+	var httpErr *HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, 404, httpErr.StatusCode)
+}
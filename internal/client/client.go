@@ -10,10 +10,18 @@ import (
 	"time"
 )
 
+const (
+	agentTargetHeader = "X-PortainerAgent-Target"
+	apiKeyHeader      = "X-API-Key"
+)
+
 type Client struct {
-	baseURL    string
-	httpClient *http.Client
-	token      string
+	baseURL      string
+	httpClient   *http.Client
+	streamClient *http.Client
+	token        string
+	apiKey       string
+	agentTarget  string
 }
 
 type HTTPError struct {
@@ -31,6 +39,7 @@ func New(baseURL string) *Client {
 		httpClient: &http.Client{
 			Timeout: 30 * time.Second,
 		},
+		streamClient: &http.Client{},
 	}
 }
 
@@ -38,6 +47,27 @@ func (c *Client) SetToken(token string) {
 	c.token = token
 }
 
+func (c *Client) SetAPIKey(apiKey string) {
+	c.apiKey = apiKey
+}
+
+func (c *Client) ForNode(node string) *Client {
+	clone := *c
+	clone.agentTarget = node
+	return &clone
+}
+
+func (c *Client) setHeaders(req *http.Request) {
+	if c.apiKey != "" {
+		req.Header.Set(apiKeyHeader, c.apiKey)
+	} else if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	if c.agentTarget != "" {
+		req.Header.Set(agentTargetHeader, c.agentTarget)
+	}
+}
+
 func (c *Client) doRequest(ctx context.Context, method, path string, body, result interface{}) error {
 	url := c.baseURL + path
 
@@ -56,9 +86,7 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body, resul
 	}
 
 	req.Header.Set("Content-Type", "application/json")
-	if c.token != "" {
-		req.Header.Set("Authorization", "Bearer "+c.token)
-	}
+	c.setHeaders(req)
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
@@ -86,3 +114,31 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body, resul
 
 	return nil
 }
+
+func (c *Client) doStreamRequest(ctx context.Context, method, path string, headers map[string]string, body io.Reader) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+	c.setHeaders(req)
+
+	resp, err := c.streamClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		defer resp.Body.Close()
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, &HTTPError{
+			StatusCode: resp.StatusCode,
+			Message:    string(respBody),
+		}
+	}
+
+	return resp, nil
+}
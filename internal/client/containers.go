@@ -0,0 +1,144 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+)
+
+func (c *Client) ListContainers(ctx context.Context, endpointID int, filters *types.ContainerFilters) ([]types.Container, error) {
+	params := url.Values{}
+	dockerFilters := map[string][]string{}
+
+	if filters != nil {
+		if filters.All {
+			params.Set("all", "1")
+		}
+		if len(filters.Labels) > 0 {
+			dockerFilters["label"] = filters.Labels
+		}
+		if len(filters.Status) > 0 {
+			dockerFilters["status"] = filters.Status
+		}
+	}
+
+	if err := encodeDockerFilters(params, dockerFilters); err != nil {
+		return nil, err
+	}
+
+	var containers []types.Container
+	err := c.dockerRequest(ctx, endpointID, "GET", withQuery("/containers/json", params), nil, &containers)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list containers: %w", err)
+	}
+
+	// Swarm and compose stacks use different labels, and Docker ANDs label
+	// filters, so the stack filter is applied on the result instead.
+	if filters != nil && filters.Stack != "" {
+		filtered := make([]types.Container, 0, len(containers))
+		for _, container := range containers {
+			if container.StackName() == filters.Stack {
+				filtered = append(filtered, container)
+			}
+		}
+		containers = filtered
+	}
+
+	return containers, nil
+}
+
+func (c *Client) InspectContainer(ctx context.Context, endpointID int, containerID string) (*types.ContainerDetails, error) {
+	path := fmt.Sprintf("/containers/%s/json", url.PathEscape(containerID))
+
+	var container types.ContainerDetails
+	err := c.dockerRequest(ctx, endpointID, "GET", path, nil, &container)
+	if err != nil {
+		return nil, fmt.Errorf("failed to inspect container: %w", err)
+	}
+
+	return &container, nil
+}
+
+func (c *Client) StartContainer(ctx context.Context, endpointID int, containerID string) error {
+	path := fmt.Sprintf("/containers/%s/start", url.PathEscape(containerID))
+
+	// Docker answers 304 when the container is already running
+	err := c.dockerRequest(ctx, endpointID, "POST", path, nil, nil)
+	if err != nil && !isNotModified(err) {
+		return fmt.Errorf("failed to start container: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) StopContainer(ctx context.Context, endpointID int, containerID string, timeout int) error {
+	params := url.Values{}
+	if timeout >= 0 {
+		params.Set("t", strconv.Itoa(timeout))
+	}
+	path := withQuery(fmt.Sprintf("/containers/%s/stop", url.PathEscape(containerID)), params)
+
+	err := c.dockerRequest(ctx, endpointID, "POST", path, nil, nil)
+	if err != nil && !isNotModified(err) {
+		return fmt.Errorf("failed to stop container: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) RestartContainer(ctx context.Context, endpointID int, containerID string, timeout int) error {
+	params := url.Values{}
+	if timeout >= 0 {
+		params.Set("t", strconv.Itoa(timeout))
+	}
+	path := withQuery(fmt.Sprintf("/containers/%s/restart", url.PathEscape(containerID)), params)
+
+	err := c.dockerRequest(ctx, endpointID, "POST", path, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to restart container: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) KillContainer(ctx context.Context, endpointID int, containerID string, signal string) error {
+	params := url.Values{}
+	if signal != "" {
+		params.Set("signal", signal)
+	}
+	path := withQuery(fmt.Sprintf("/containers/%s/kill", url.PathEscape(containerID)), params)
+
+	err := c.dockerRequest(ctx, endpointID, "POST", path, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to kill container: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) RemoveContainer(ctx context.Context, endpointID int, containerID string, force bool, removeVolumes bool) error {
+	params := url.Values{}
+	if force {
+		params.Set("force", "true")
+	}
+	if removeVolumes {
+		params.Set("v", "true")
+	}
+	path := withQuery(fmt.Sprintf("/containers/%s", url.PathEscape(containerID)), params)
+
+	err := c.dockerRequest(ctx, endpointID, "DELETE", path, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to remove container: %w", err)
+	}
+
+	return nil
+}
+
+func isNotModified(err error) bool {
+	var httpErr *HTTPError
+	return errors.As(err, &httpErr) && httpErr.StatusCode == 304
+}
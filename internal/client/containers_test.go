@@ -0,0 +1,123 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_ListContainers_WithFilters(t *testing.T) {
+	containers := []types.Container{
+		{ID: "a1", Names: []string{"/web-1"}, State: "running", Labels: map[string]string{types.StackNamespaceLabel: "web"}},
+		{ID: "b2", Names: []string{"/api-1"}, State: "running", Labels: map[string]string{types.ComposeProjectLabel: "api"}},
+		{ID: "c3", Names: []string{"/web-2"}, State: "exited", Labels: map[string]string{types.ComposeProjectLabel: "web"}},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/endpoints/1/docker/containers/json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		assert.Equal(t, "1", r.URL.Query().Get("all"))
+
+		var filters map[string][]string
+		require.NoError(t, json.Unmarshal([]byte(r.URL.Query().Get("filters")), &filters))
+		assert.Equal(t, []string{"tier=frontend"}, filters["label"])
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(containers)
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	result, err := client.ListContainers(context.Background(), 1, &types.ContainerFilters{
+		All:    true,
+		Stack:  "web",
+		Labels: []string{"tier=frontend"},
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, result, 2)
+	assert.Equal(t, "web-1", result[0].Name())
+	assert.Equal(t, "web-2", result[1].Name())
+}
+
+func TestClient_ListContainers_NoFilters(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.RawQuery != "" {
+			t.Errorf("expected no query parameters, got %s", r.URL.RawQuery)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	result, err := client.ListContainers(context.Background(), 1, nil)
+
+	require.NoError(t, err)
+	assert.Empty(t, result)
+}
+
+func TestClient_StartContainer_AlreadyStarted(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/api/endpoints/2/docker/containers/web/start" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNotModified)
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	err := client.StartContainer(context.Background(), 2, "web")
+
+	require.NoError(t, err)
+}
+
+func TestClient_StopContainer_WithTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/endpoints/1/docker/containers/web/stop", r.URL.Path)
+		assert.Equal(t, "t=30", r.URL.RawQuery)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	err := client.StopContainer(context.Background(), 1, "web", 30)
+
+	require.NoError(t, err)
+}
+
+func TestClient_RemoveContainer_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, "force=true&v=true", r.URL.RawQuery)
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message":"No such container: web"}`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	err := client.RemoveContainer(context.Background(), 1, "web", true, true)
+
+	require.Error(t, err)
+	var httpErr *HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, 404, httpErr.StatusCode)
+}
@@ -0,0 +1,57 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+)
+
+func (c *Client) ListCustomTemplates(ctx context.Context) ([]types.CustomTemplate, error) {
+	var templates []types.CustomTemplate
+	err := c.doRequest(ctx, "GET", "/api/custom_templates", nil, &templates)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list custom templates: %w", err)
+	}
+
+	return templates, nil
+}
+
+func (c *Client) GetCustomTemplate(ctx context.Context, templateID int) (*types.CustomTemplate, error) {
+	var template types.CustomTemplate
+	err := c.doRequest(ctx, "GET", fmt.Sprintf("/api/custom_templates/%d", templateID), nil, &template)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get custom template: %w", err)
+	}
+
+	return &template, nil
+}
+
+func (c *Client) GetCustomTemplateFile(ctx context.Context, templateID int) (string, error) {
+	var resp types.CustomTemplateFileResponse
+	err := c.doRequest(ctx, "GET", fmt.Sprintf("/api/custom_templates/%d/file", templateID), nil, &resp)
+	if err != nil {
+		return "", fmt.Errorf("failed to get custom template file: %w", err)
+	}
+
+	return resp.FileContent, nil
+}
+
+func (c *Client) CreateCustomTemplate(ctx context.Context, request types.CustomTemplateCreateRequest) (*types.CustomTemplate, error) {
+	var template types.CustomTemplate
+	err := c.doRequest(ctx, "POST", "/api/custom_templates/create/string", request, &template)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create custom template: %w", err)
+	}
+
+	return &template, nil
+}
+
+func (c *Client) DeleteCustomTemplate(ctx context.Context, templateID int) error {
+	err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/api/custom_templates/%d", templateID), nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete custom template: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_CreateCustomTemplate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/api/custom_templates/create/string", r.URL.Path)
+
+		var req types.CustomTemplateCreateRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "Web API", req.Title)
+		assert.Equal(t, types.StackTypeDockerSwarm, req.Type)
+		assert.Equal(t, types.TemplatePlatformLinux, req.Platform)
+		assert.Equal(t, []types.CustomTemplateVariable{{Name: "REPLICAS", Label: "REPLICAS", DefaultValue: "2"}}, req.Variables)
+
+		w.Write([]byte(`{"Id":12,"Title":"Web API","Type":2,"Platform":1}`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	template, err := client.CreateCustomTemplate(context.Background(), types.CustomTemplateCreateRequest{
+		Title:       "Web API",
+		Description: "Standard web API stack",
+		FileContent: "services: {}",
+		Platform:    types.TemplatePlatformLinux,
+		Type:        types.StackTypeDockerSwarm,
+		Variables:   []types.CustomTemplateVariable{{Name: "REPLICAS", Label: "REPLICAS", DefaultValue: "2"}},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 12, template.ID)
+}
+
+func TestClient_GetCustomTemplateFile(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/custom_templates/12/file", r.URL.Path)
+		w.Write([]byte(`{"FileContent":"services: {}"}`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	content, err := client.GetCustomTemplateFile(context.Background(), 12)
+
+	require.NoError(t, err)
+	assert.Equal(t, "services: {}", content)
+}
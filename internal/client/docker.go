@@ -0,0 +1,37 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+func dockerPath(endpointID int, path string) string {
+	return fmt.Sprintf("/api/endpoints/%d/docker%s", endpointID, path)
+}
+
+func (c *Client) dockerRequest(ctx context.Context, endpointID int, method, path string, body, result interface{}) error {
+	return c.doRequest(ctx, method, dockerPath(endpointID, path), body, result)
+}
+
+func encodeDockerFilters(params url.Values, filters map[string][]string) error {
+	if len(filters) == 0 {
+		return nil
+	}
+
+	encoded, err := json.Marshal(filters)
+	if err != nil {
+		return fmt.Errorf("failed to encode filters: %w", err)
+	}
+	params.Set("filters", string(encoded))
+
+	return nil
+}
+
+func withQuery(path string, params url.Values) string {
+	if len(params) == 0 {
+		return path
+	}
+	return path + "?" + params.Encode()
+}
@@ -0,0 +1,38 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+)
+
+func (c *Client) ListEdgeGroups(ctx context.Context) ([]types.EdgeGroup, error) {
+	var groups []types.EdgeGroup
+	err := c.doRequest(ctx, "GET", "/api/edge_groups", nil, &groups)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list edge groups: %w", err)
+	}
+
+	return groups, nil
+}
+
+func (c *Client) CreateEdgeGroup(ctx context.Context, request types.EdgeGroupRequest) (*types.EdgeGroup, error) {
+	var group types.EdgeGroup
+	err := c.doRequest(ctx, "POST", "/api/edge_groups", request, &group)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create edge group: %w", err)
+	}
+
+	return &group, nil
+}
+
+func (c *Client) UpdateEdgeGroup(ctx context.Context, groupID int, request types.EdgeGroupRequest) (*types.EdgeGroup, error) {
+	var group types.EdgeGroup
+	err := c.doRequest(ctx, "PUT", fmt.Sprintf("/api/edge_groups/%d", groupID), request, &group)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update edge group: %w", err)
+	}
+
+	return &group, nil
+}
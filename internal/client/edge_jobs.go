@@ -0,0 +1,79 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+)
+
+func (c *Client) ListEdgeJobs(ctx context.Context) ([]types.EdgeJob, error) {
+	var jobs []types.EdgeJob
+	err := c.doRequest(ctx, "GET", "/api/edge_jobs", nil, &jobs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list edge jobs: %w", err)
+	}
+
+	return jobs, nil
+}
+
+func (c *Client) CreateEdgeJob(ctx context.Context, request types.EdgeJobCreateRequest) (*types.EdgeJob, error) {
+	var job types.EdgeJob
+	err := c.doRequest(ctx, "POST", "/api/edge_jobs/create/string", request, &job)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create edge job: %w", err)
+	}
+
+	return &job, nil
+}
+
+func (c *Client) ListEdgeJobTasks(ctx context.Context, jobID int) ([]types.EdgeJobTask, error) {
+	var tasks []types.EdgeJobTask
+	err := c.doRequest(ctx, "GET", fmt.Sprintf("/api/edge_jobs/%d/tasks", jobID), nil, &tasks)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list edge job tasks: %w", err)
+	}
+
+	return tasks, nil
+}
+
+func (c *Client) CollectEdgeJobLogs(ctx context.Context, jobID int, endpointID int) error {
+	// Tasks are identified by the ID of the environment running them
+	err := c.doRequest(ctx, "POST", fmt.Sprintf("/api/edge_jobs/%d/tasks/%d/logs", jobID, endpointID), nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to request edge job logs: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) GetEdgeJobLogs(ctx context.Context, jobID int, endpointID int) (string, error) {
+	var resp types.EdgeJobLogsResponse
+	err := c.doRequest(ctx, "GET", fmt.Sprintf("/api/edge_jobs/%d/tasks/%d/logs", jobID, endpointID), nil, &resp)
+	if err != nil {
+		return "", fmt.Errorf("failed to get edge job logs: %w", err)
+	}
+
+	return resp.FileContent, nil
+}
+
+func (c *Client) WaitForEdgeJobLogs(ctx context.Context, jobID int, endpointID int, pollInterval time.Duration) error {
+	for {
+		tasks, err := c.ListEdgeJobTasks(ctx, jobID)
+		if err != nil {
+			return err
+		}
+		for _, task := range tasks {
+			if task.EndpointID == endpointID && task.LogsStatus == types.EdgeJobLogsStatusCollected {
+				return nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for the edge agent to upload the logs: %w", ctx.Err())
+		case <-time.After(pollInterval):
+		}
+	}
+}
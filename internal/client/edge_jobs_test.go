@@ -0,0 +1,54 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_WaitForEdgeJobLogs(t *testing.T) {
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/edge_jobs/3/tasks", r.URL.Path)
+		polls++
+		if polls < 3 {
+			w.Write([]byte(`[{"Id":"edgejob_task_3_10","EndpointId":10,"LogsStatus":2},{"Id":"edgejob_task_3_11","EndpointId":11,"LogsStatus":3}]`))
+			return
+		}
+		w.Write([]byte(`[{"Id":"edgejob_task_3_10","EndpointId":10,"LogsStatus":3}]`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	err := client.WaitForEdgeJobLogs(ctx, 3, 10, 10*time.Millisecond)
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, polls)
+}
+
+func TestClient_GetEdgeJobLogs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/api/edge_jobs/3/tasks/10/logs", r.URL.Path)
+		w.Write([]byte(`{"FileContent":"Deleted 4 images\n"}`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	logs, err := client.GetEdgeJobLogs(context.Background(), 3, 10)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Deleted 4 images\n", logs)
+}
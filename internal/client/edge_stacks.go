@@ -0,0 +1,77 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+)
+
+func (c *Client) ListEdgeStacks(ctx context.Context) ([]types.EdgeStack, error) {
+	var stacks []types.EdgeStack
+	err := c.doRequest(ctx, "GET", "/api/edge_stacks", nil, &stacks)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list edge stacks: %w", err)
+	}
+
+	return stacks, nil
+}
+
+func (c *Client) GetEdgeStack(ctx context.Context, stackID int) (*types.EdgeStack, error) {
+	var stack types.EdgeStack
+	err := c.doRequest(ctx, "GET", fmt.Sprintf("/api/edge_stacks/%d", stackID), nil, &stack)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get edge stack: %w", err)
+	}
+
+	return &stack, nil
+}
+
+func (c *Client) GetEdgeStackFile(ctx context.Context, stackID int) (string, error) {
+	var resp types.EdgeStackFileResponse
+	err := c.doRequest(ctx, "GET", fmt.Sprintf("/api/edge_stacks/%d/file", stackID), nil, &resp)
+	if err != nil {
+		return "", fmt.Errorf("failed to get edge stack file: %w", err)
+	}
+
+	return resp.StackFileContent, nil
+}
+
+func (c *Client) CreateEdgeStackFromString(ctx context.Context, request types.EdgeStackCreateFromStringRequest) (*types.EdgeStack, error) {
+	var stack types.EdgeStack
+	err := c.doRequest(ctx, "POST", "/api/edge_stacks/create/string", request, &stack)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create edge stack: %w", err)
+	}
+
+	return &stack, nil
+}
+
+func (c *Client) CreateEdgeStackFromGit(ctx context.Context, request types.EdgeStackCreateFromGitRequest) (*types.EdgeStack, error) {
+	var stack types.EdgeStack
+	err := c.doRequest(ctx, "POST", "/api/edge_stacks/create/repository", request, &stack)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create edge stack from git: %w", err)
+	}
+
+	return &stack, nil
+}
+
+func (c *Client) UpdateEdgeStack(ctx context.Context, stackID int, request types.EdgeStackUpdateRequest) (*types.EdgeStack, error) {
+	var stack types.EdgeStack
+	err := c.doRequest(ctx, "PUT", fmt.Sprintf("/api/edge_stacks/%d", stackID), request, &stack)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update edge stack: %w", err)
+	}
+
+	return &stack, nil
+}
+
+func (c *Client) DeleteEdgeStack(ctx context.Context, stackID int) error {
+	err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/api/edge_stacks/%d", stackID), nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete edge stack: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_CreateEdgeStackFromString(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/api/edge_stacks/create/string", r.URL.Path)
+
+		var req types.EdgeStackCreateFromStringRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "telemetry", req.Name)
+		assert.Equal(t, []int{1, 2}, req.EdgeGroups)
+		assert.Equal(t, types.EdgeStackDeploymentCompose, req.DeploymentType)
+
+		w.Write([]byte(`{"Id":4,"Name":"telemetry","EdgeGroups":[1,2],"Version":1,"Status":{}}`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	stack, err := client.CreateEdgeStackFromString(context.Background(), types.EdgeStackCreateFromStringRequest{
+		Name:             "telemetry",
+		StackFileContent: "services: {}",
+		EdgeGroups:       []int{1, 2},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 4, stack.ID)
+}
+
+func TestClient_GetEdgeStack_DecodesStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/edge_stacks/4", r.URL.Path)
+		w.Write([]byte(`{"Id":4,"Name":"telemetry","Status":{"10":{"EndpointID":10,"Status":[{"Type":1,"Time":100},{"Type":7,"Time":200}]}}}`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	stack, err := client.GetEdgeStack(context.Background(), 4)
+
+	require.NoError(t, err)
+	current, ok := stack.Status[10].Current()
+	require.True(t, ok)
+	assert.Equal(t, types.EdgeStackStatusRunning, current.Type)
+}
@@ -0,0 +1,46 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+)
+
+func (c *Client) ListEndpointGroups(ctx context.Context) ([]types.EndpointGroup, error) {
+	var groups []types.EndpointGroup
+	err := c.doRequest(ctx, "GET", "/api/endpoint_groups", nil, &groups)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list endpoint groups: %w", err)
+	}
+
+	return groups, nil
+}
+
+func (c *Client) CreateEndpointGroup(ctx context.Context, request types.EndpointGroupCreateRequest) (*types.EndpointGroup, error) {
+	var group types.EndpointGroup
+	err := c.doRequest(ctx, "POST", "/api/endpoint_groups", request, &group)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create endpoint group: %w", err)
+	}
+
+	return &group, nil
+}
+
+func (c *Client) AddEndpointToGroup(ctx context.Context, groupID int, endpointID int) error {
+	err := c.doRequest(ctx, "PUT", fmt.Sprintf("/api/endpoint_groups/%d/endpoints/%d", groupID, endpointID), nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to add endpoint to group: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) RemoveEndpointFromGroup(ctx context.Context, groupID int, endpointID int) error {
+	err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/api/endpoint_groups/%d/endpoints/%d", groupID, endpointID), nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to remove endpoint from group: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+)
+
+func (c *Client) ListEndpoints(ctx context.Context, filters *types.EndpointFilters) ([]types.Endpoint, error) {
+	path := "/api/endpoints"
+
+	if filters != nil && filters.Search != "" {
+		params := url.Values{}
+		params.Add("search", filters.Search)
+		path += "?" + params.Encode()
+	}
+
+	var endpoints []types.Endpoint
+	err := c.doRequest(ctx, "GET", path, nil, &endpoints)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list endpoints: %w", err)
+	}
+
+	return endpoints, nil
+}
+
+func (c *Client) FindEndpointByName(ctx context.Context, name string) (*types.Endpoint, error) {
+	endpoints, err := c.ListEndpoints(ctx, &types.EndpointFilters{Search: name})
+	if err != nil {
+		return nil, err
+	}
+
+	// search is a substring match, so only an exact name is accepted
+	for _, endpoint := range endpoints {
+		if endpoint.Name == name {
+			return &endpoint, nil
+		}
+	}
+
+	return nil, fmt.Errorf("endpoint %q not found", name)
+}
+
+func (c *Client) GetEndpoint(ctx context.Context, endpointID int) (*types.Endpoint, error) {
+	path := fmt.Sprintf("/api/endpoints/%d", endpointID)
+
+	var endpoint types.Endpoint
+	err := c.doRequest(ctx, "GET", path, nil, &endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get endpoint: %w", err)
+	}
+
+	return &endpoint, nil
+}
+
+func (c *Client) GetDockerInfo(ctx context.Context, endpointID int) (*types.DockerInfo, error) {
+	var info types.DockerInfo
+	err := c.dockerRequest(ctx, endpointID, "GET", "/info", nil, &info)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get docker info: %w", err)
+	}
+
+	return &info, nil
+}
+
+func (c *Client) GetSwarmID(ctx context.Context, endpointID int) (string, error) {
+	info, err := c.GetDockerInfo(ctx, endpointID)
+	if err != nil {
+		return "", err
+	}
+
+	if info.Swarm.Cluster == nil || info.Swarm.Cluster.ID == "" {
+		return "", fmt.Errorf("endpoint %d is not a swarm manager", endpointID)
+	}
+
+	return info.Swarm.Cluster.ID, nil
+}
@@ -0,0 +1,135 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_ListEndpoints_Success(t *testing.T) {
+	endpoints := []types.Endpoint{
+		{ID: 1, Name: "prod-swarm", Type: types.EndpointTypeAgentOnDocker, Status: types.EndpointStatusUp},
+		{ID: 2, Name: "k8s", Type: types.EndpointTypeAgentOnKubernetes, Status: types.EndpointStatusDown},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || r.URL.Path != "/api/endpoints" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(endpoints)
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	result, err := client.ListEndpoints(context.Background(), nil)
+
+	require.NoError(t, err)
+	assert.Len(t, result, 2)
+	assert.Equal(t, "prod-swarm", result[0].Name)
+	assert.Equal(t, types.EndpointTypeAgentOnKubernetes, result[1].Type)
+}
+
+func TestClient_GetEndpoint_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	_, err := client.GetEndpoint(context.Background(), 42)
+
+	require.Error(t, err)
+	var httpErr *HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, 404, httpErr.StatusCode)
+}
+
+func TestClient_GetSwarmID_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/endpoints/1/docker/info" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ID":"node","Swarm":{"NodeID":"n1","LocalNodeState":"active","ControlAvailable":true,"Cluster":{"ID":"jpofkc0i9uo9wtx1zesuk649w"}}}`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	swarmID, err := client.GetSwarmID(context.Background(), 1)
+
+	require.NoError(t, err)
+	assert.Equal(t, "jpofkc0i9uo9wtx1zesuk649w", swarmID)
+}
+
+func TestClient_GetSwarmID_NotSwarmManager(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ID":"node","Swarm":{"LocalNodeState":"inactive"}}`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	_, err := client.GetSwarmID(context.Background(), 3)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not a swarm manager")
+}
+
+func TestClient_FindEndpointByName_ExactMatch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("search") != "prod-swarm" {
+			t.Errorf("unexpected search: %s", r.URL.RawQuery)
+		}
+
+		endpoints := []types.Endpoint{
+			{ID: 4, Name: "prod-swarm-old"},
+			{ID: 7, Name: "prod-swarm"},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(endpoints)
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	endpoint, err := client.FindEndpointByName(context.Background(), "prod-swarm")
+
+	require.NoError(t, err)
+	assert.Equal(t, 7, endpoint.ID)
+}
+
+func TestClient_FindEndpointByName_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"Id":4,"Name":"prod-swarm-old"}]`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	_, err := client.FindEndpointByName(context.Background(), "prod-swarm")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `endpoint "prod-swarm" not found`)
+}
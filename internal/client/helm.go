@@ -0,0 +1,81 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+)
+
+func (c *Client) ListHelmRepositories(ctx context.Context, userID int) (*types.HelmRepositoryList, error) {
+	var list types.HelmRepositoryList
+	err := c.doRequest(ctx, "GET", fmt.Sprintf("/api/users/%d/helm/repositories", userID), nil, &list)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list helm repositories: %w", err)
+	}
+
+	return &list, nil
+}
+
+func (c *Client) AddHelmRepository(ctx context.Context, userID int, repositoryURL string) (*types.HelmUserRepository, error) {
+	request := types.HelmRepositoryCreateRequest{URL: repositoryURL}
+
+	var repository types.HelmUserRepository
+	err := c.doRequest(ctx, "POST", fmt.Sprintf("/api/users/%d/helm/repositories", userID), request, &repository)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add helm repository: %w", err)
+	}
+
+	return &repository, nil
+}
+
+func (c *Client) SearchHelmRepository(ctx context.Context, repositoryURL string) (*types.HelmChartIndex, error) {
+	var index types.HelmChartIndex
+	path := withQuery("/api/templates/helm", url.Values{"repo": {repositoryURL}})
+	err := c.doRequest(ctx, "GET", path, nil, &index)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search helm repository: %w", err)
+	}
+
+	return &index, nil
+}
+
+func (c *Client) ListHelmReleases(ctx context.Context, endpointID int, namespace string, filter string) ([]types.HelmRelease, error) {
+	params := url.Values{}
+	if namespace != "" {
+		params.Set("namespace", namespace)
+	}
+	if filter != "" {
+		params.Set("filter", filter)
+	}
+
+	var releases []types.HelmRelease
+	err := c.kubernetesRequest(ctx, endpointID, "GET", withQuery("/helm", params), nil, &releases)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list helm releases: %w", err)
+	}
+
+	return releases, nil
+}
+
+func (c *Client) InstallHelmChart(ctx context.Context, endpointID int, request types.HelmInstallRequest) (*types.HelmReleaseDetails, error) {
+	// Portainer installs with upgrade semantics, so the same call also upgrades an existing release
+	var release types.HelmReleaseDetails
+	err := c.kubernetesRequest(ctx, endpointID, "POST", "/helm", request, &release)
+	if err != nil {
+		return nil, fmt.Errorf("failed to install helm chart: %w", err)
+	}
+
+	return &release, nil
+}
+
+func (c *Client) UninstallHelmRelease(ctx context.Context, endpointID int, namespace string, name string) error {
+	path := withQuery("/helm/"+name, url.Values{"namespace": {namespace}})
+	err := c.kubernetesRequest(ctx, endpointID, "DELETE", path, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to uninstall helm release: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_InstallHelmChart(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/api/endpoints/3/kubernetes/helm", r.URL.Path)
+
+		var req types.HelmInstallRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "cache", req.Name)
+		assert.Equal(t, "payments", req.Namespace)
+		assert.Equal(t, "redis", req.Chart)
+		assert.Equal(t, "https://charts.bitnami.com/bitnami", req.Repo)
+		assert.Equal(t, "replicas: 3\n", req.Values)
+
+		w.Write([]byte(`{"name":"cache","namespace":"payments","version":1,"info":{"status":"deployed"},"chart":{"metadata":{"name":"redis","version":"18.0.0"}}}`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	release, err := client.InstallHelmChart(context.Background(), 3, types.HelmInstallRequest{
+		Name:      "cache",
+		Namespace: "payments",
+		Chart:     "redis",
+		Repo:      "https://charts.bitnami.com/bitnami",
+		Values:    "replicas: 3\n",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, release.Version)
+	assert.Equal(t, "deployed", release.Info.Status)
+	assert.Equal(t, "18.0.0", release.Chart.Metadata.Version)
+}
+
+func TestClient_UninstallHelmRelease(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, "/api/endpoints/3/kubernetes/helm/cache", r.URL.Path)
+		assert.Equal(t, "payments", r.URL.Query().Get("namespace"))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	err := client.UninstallHelmRelease(context.Background(), 3, "payments", "cache")
+
+	require.NoError(t, err)
+}
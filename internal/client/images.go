@@ -0,0 +1,135 @@
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+)
+
+func (c *Client) ListImages(ctx context.Context, endpointID int) ([]types.Image, error) {
+	var images []types.Image
+	err := c.dockerRequest(ctx, endpointID, "GET", "/images/json", nil, &images)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list images: %w", err)
+	}
+
+	return images, nil
+}
+
+func (c *Client) PullImage(ctx context.Context, endpointID int, ref string, registryID int, progress func(types.JSONMessage)) error {
+	name, tag := SplitImageReference(ref)
+
+	params := url.Values{}
+	params.Set("fromImage", name)
+	if tag != "" {
+		params.Set("tag", tag)
+	}
+
+	// Portainer replaces a registryId auth header with the credentials it
+	// stores for that registry before forwarding the request to Docker.
+	headers := map[string]string{}
+	if registryID > 0 {
+		auth, err := json.Marshal(map[string]int{"registryId": registryID})
+		if err != nil {
+			return fmt.Errorf("failed to encode registry auth: %w", err)
+		}
+		headers["X-Registry-Auth"] = base64.StdEncoding.EncodeToString(auth)
+	}
+
+	resp, err := c.doStreamRequest(ctx, "POST", dockerPath(endpointID, withQuery("/images/create", params)), headers, nil)
+	if err != nil {
+		return fmt.Errorf("failed to pull image: %w", err)
+	}
+	defer resp.Body.Close()
+
+	decoder := json.NewDecoder(resp.Body)
+	for {
+		var message types.JSONMessage
+		if err := decoder.Decode(&message); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("failed to read pull progress: %w", err)
+		}
+
+		if message.Error != "" {
+			return fmt.Errorf("failed to pull image: %s", message.Error)
+		}
+		if progress != nil {
+			progress(message)
+		}
+	}
+}
+
+func (c *Client) RemoveImage(ctx context.Context, endpointID int, ref string, force bool, noPrune bool) ([]types.ImageDeleteResponseItem, error) {
+	params := url.Values{}
+	if force {
+		params.Set("force", "true")
+	}
+	if noPrune {
+		params.Set("noprune", "true")
+	}
+	path := withQuery(fmt.Sprintf("/images/%s", url.PathEscape(ref)), params)
+
+	var items []types.ImageDeleteResponseItem
+	err := c.dockerRequest(ctx, endpointID, "DELETE", path, nil, &items)
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove image: %w", err)
+	}
+
+	return items, nil
+}
+
+func (c *Client) PruneImages(ctx context.Context, endpointID int, all bool) (*types.ImagePruneReport, error) {
+	params := url.Values{}
+	dangling := "true"
+	if all {
+		dangling = "false"
+	}
+	if err := encodeDockerFilters(params, map[string][]string{"dangling": {dangling}}); err != nil {
+		return nil, err
+	}
+
+	var report types.ImagePruneReport
+	err := c.dockerRequest(ctx, endpointID, "POST", withQuery("/images/prune", params), nil, &report)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prune images: %w", err)
+	}
+
+	return &report, nil
+}
+
+func SplitImageReference(ref string) (string, string) {
+	if strings.Contains(ref, "@") {
+		return ref, ""
+	}
+
+	lastSlash := strings.LastIndex(ref, "/")
+	if lastColon := strings.LastIndex(ref, ":"); lastColon > lastSlash {
+		return ref[:lastColon], ref[lastColon+1:]
+	}
+
+	// Without a tag Docker would pull every tag of the repository
+	return ref, "latest"
+}
+
+func ImageRegistryHost(ref string) string {
+	firstSlash := strings.Index(ref, "/")
+	if firstSlash < 0 {
+		return "docker.io"
+	}
+
+	host := ref[:firstSlash]
+	if strings.ContainsAny(host, ".:") || host == "localhost" {
+		return host
+	}
+
+	return "docker.io"
+}
@@ -0,0 +1,123 @@
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_PullImage_StreamsProgress(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/api/endpoints/1/docker/images/create", r.URL.Path)
+		assert.Equal(t, "registry.example.com/team/api", r.URL.Query().Get("fromImage"))
+		assert.Equal(t, "1.4.2", r.URL.Query().Get("tag"))
+
+		auth, err := base64.StdEncoding.DecodeString(r.Header.Get("X-Registry-Auth"))
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"registryId":3}`, string(auth))
+
+		w.Write([]byte(`{"status":"Pulling from team/api","id":"1.4.2"}
+{"status":"Downloading","id":"a1b2","progressDetail":{"current":10,"total":100}}
+{"status":"Pull complete","id":"a1b2"}
+{"status":"Status: Downloaded newer image for registry.example.com/team/api:1.4.2"}
+`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	var messages []types.JSONMessage
+	err := client.PullImage(context.Background(), 1, "registry.example.com/team/api:1.4.2", 3, func(message types.JSONMessage) {
+		messages = append(messages, message)
+	})
+
+	require.NoError(t, err)
+	require.Len(t, messages, 4)
+	assert.Equal(t, "Pull complete", messages[2].Status)
+}
+
+func TestClient_PullImage_ErrorInStream(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("X-Registry-Auth"))
+		w.Write([]byte(`{"status":"Pulling from library/nginx"}
+{"errorDetail":{"message":"manifest unknown"},"error":"manifest unknown"}
+`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	err := client.PullImage(context.Background(), 1, "nginx:doesnotexist", 0, nil)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "manifest unknown")
+}
+
+func TestClient_PruneImages_All(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/endpoints/1/docker/images/prune", r.URL.Path)
+		assert.JSONEq(t, `{"dangling":["false"]}`, r.URL.Query().Get("filters"))
+		w.Write([]byte(`{"ImagesDeleted":[{"Deleted":"sha256:abc"}],"SpaceReclaimed":2048}`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	report, err := client.PruneImages(context.Background(), 1, true)
+
+	require.NoError(t, err)
+	assert.Len(t, report.ImagesDeleted, 1)
+	assert.Equal(t, int64(2048), report.SpaceReclaimed)
+}
+
+func TestClient_ForNode_SetsAgentTarget(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "worker-2", r.Header.Get("X-PortainerAgent-Target"))
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	_, err := client.ForNode("worker-2").ListImages(context.Background(), 1)
+
+	require.NoError(t, err)
+}
+
+func TestSplitImageReference(t *testing.T) {
+	tests := []struct {
+		ref  string
+		name string
+		tag  string
+	}{
+		{"nginx", "nginx", "latest"},
+		{"nginx:1.27", "nginx", "1.27"},
+		{"localhost:5000/team/api", "localhost:5000/team/api", "latest"},
+		{"localhost:5000/team/api:2", "localhost:5000/team/api", "2"},
+		{"nginx@sha256:abc", "nginx@sha256:abc", ""},
+	}
+
+	for _, test := range tests {
+		name, tag := SplitImageReference(test.ref)
+		assert.Equal(t, test.name, name, test.ref)
+		assert.Equal(t, test.tag, tag, test.ref)
+	}
+}
+
+func TestImageRegistryHost(t *testing.T) {
+	assert.Equal(t, "docker.io", ImageRegistryHost("nginx"))
+	assert.Equal(t, "docker.io", ImageRegistryHost("team/api:1"))
+	assert.Equal(t, "registry.example.com", ImageRegistryHost("registry.example.com/team/api:1"))
+	assert.Equal(t, "localhost:5000", ImageRegistryHost("localhost:5000/api"))
+}
@@ -0,0 +1,94 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+)
+
+func kubernetesPath(endpointID int, path string) string {
+	return fmt.Sprintf("/api/endpoints/%d/kubernetes%s", endpointID, path)
+}
+
+func namespacedPath(group, namespace, resource string) string {
+	if namespace == "" {
+		return fmt.Sprintf("%s/%s", group, resource)
+	}
+	return fmt.Sprintf("%s/namespaces/%s/%s", group, namespace, resource)
+}
+
+func (c *Client) kubernetesRequest(ctx context.Context, endpointID int, method, path string, body, result interface{}) error {
+	return c.doRequest(ctx, method, kubernetesPath(endpointID, path), body, result)
+}
+
+func (c *Client) ListNamespaces(ctx context.Context, endpointID int) ([]types.KubernetesNamespace, error) {
+	var list types.KubernetesNamespaceList
+	err := c.kubernetesRequest(ctx, endpointID, "GET", "/api/v1/namespaces", nil, &list)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list namespaces: %w", err)
+	}
+
+	return list.Items, nil
+}
+
+func (c *Client) CreateNamespace(ctx context.Context, endpointID int, name string, labels map[string]string) (*types.KubernetesNamespace, error) {
+	request := types.KubernetesNamespace{
+		APIVersion: "v1",
+		Kind:       "Namespace",
+		Metadata:   types.KubernetesObjectMeta{Name: name, Labels: labels},
+	}
+
+	var namespace types.KubernetesNamespace
+	err := c.kubernetesRequest(ctx, endpointID, "POST", "/api/v1/namespaces", request, &namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create namespace: %w", err)
+	}
+
+	return &namespace, nil
+}
+
+func (c *Client) DeleteNamespace(ctx context.Context, endpointID int, name string) error {
+	err := c.kubernetesRequest(ctx, endpointID, "DELETE", "/api/v1/namespaces/"+name, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete namespace: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) ListDeployments(ctx context.Context, endpointID int, namespace string) ([]types.KubernetesDeployment, error) {
+	var list struct {
+		Items []types.KubernetesDeployment `json:"items"`
+	}
+	err := c.kubernetesRequest(ctx, endpointID, "GET", namespacedPath("/apis/apps/v1", namespace, "deployments"), nil, &list)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list deployments: %w", err)
+	}
+
+	return list.Items, nil
+}
+
+func (c *Client) ListStatefulSets(ctx context.Context, endpointID int, namespace string) ([]types.KubernetesStatefulSet, error) {
+	var list struct {
+		Items []types.KubernetesStatefulSet `json:"items"`
+	}
+	err := c.kubernetesRequest(ctx, endpointID, "GET", namespacedPath("/apis/apps/v1", namespace, "statefulsets"), nil, &list)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list statefulsets: %w", err)
+	}
+
+	return list.Items, nil
+}
+
+func (c *Client) ListDaemonSets(ctx context.Context, endpointID int, namespace string) ([]types.KubernetesDaemonSet, error) {
+	var list struct {
+		Items []types.KubernetesDaemonSet `json:"items"`
+	}
+	err := c.kubernetesRequest(ctx, endpointID, "GET", namespacedPath("/apis/apps/v1", namespace, "daemonsets"), nil, &list)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list daemonsets: %w", err)
+	}
+
+	return list.Items, nil
+}
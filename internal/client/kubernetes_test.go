@@ -0,0 +1,68 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_CreateNamespace(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/api/endpoints/3/kubernetes/api/v1/namespaces", r.URL.Path)
+
+		var req types.KubernetesNamespace
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "Namespace", req.Kind)
+		assert.Equal(t, "payments", req.Metadata.Name)
+		assert.Equal(t, map[string]string{"team": "payments"}, req.Metadata.Labels)
+
+		w.Write([]byte(`{"metadata":{"name":"payments"},"status":{"phase":"Active"}}`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	namespace, err := client.CreateNamespace(context.Background(), 3, "payments", map[string]string{"team": "payments"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Active", namespace.Status.Phase)
+}
+
+func TestClient_ListDeployments_Namespaced(t *testing.T) {
+	tests := []struct {
+		name      string
+		namespace string
+		path      string
+	}{
+		{name: "all namespaces", namespace: "", path: "/api/endpoints/3/kubernetes/apis/apps/v1/deployments"},
+		{name: "single namespace", namespace: "payments", path: "/api/endpoints/3/kubernetes/apis/apps/v1/namespaces/payments/deployments"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, tt.path, r.URL.Path)
+				w.Write([]byte(`{"items":[{"metadata":{"name":"api","namespace":"payments"},"spec":{"replicas":3},"status":{"readyReplicas":2}}]}`))
+			}))
+			defer server.Close()
+
+			client := New(server.URL)
+			client.SetToken("test-token")
+
+			deployments, err := client.ListDeployments(context.Background(), 3, tt.namespace)
+
+			require.NoError(t, err)
+			require.Len(t, deployments, 1)
+			assert.Equal(t, 3, deployments[0].Spec.DesiredReplicas())
+			assert.Equal(t, 2, deployments[0].Status.ReadyReplicas)
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+)
+
+func (c *Client) ListNetworks(ctx context.Context, endpointID int, filters *types.NetworkFilters) ([]types.Network, error) {
+	params := url.Values{}
+	dockerFilters := map[string][]string{}
+
+	// Docker never reports predefined networks (bridge, host, ingress...) as dangling
+	if filters != nil && filters.Dangling {
+		dockerFilters["dangling"] = []string{"true"}
+	}
+
+	if err := encodeDockerFilters(params, dockerFilters); err != nil {
+		return nil, err
+	}
+
+	var networks []types.Network
+	err := c.dockerRequest(ctx, endpointID, "GET", withQuery("/networks", params), nil, &networks)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list networks: %w", err)
+	}
+
+	if filters != nil && filters.Stack != "" {
+		filtered := make([]types.Network, 0, len(networks))
+		for _, network := range networks {
+			if network.StackName() == filters.Stack {
+				filtered = append(filtered, network)
+			}
+		}
+		networks = filtered
+	}
+
+	return networks, nil
+}
+
+func (c *Client) InspectNetwork(ctx context.Context, endpointID int, id string) (*types.Network, error) {
+	path := fmt.Sprintf("/networks/%s", url.PathEscape(id))
+
+	var network types.Network
+	err := c.dockerRequest(ctx, endpointID, "GET", path, nil, &network)
+	if err != nil {
+		return nil, fmt.Errorf("failed to inspect network: %w", err)
+	}
+
+	return &network, nil
+}
+
+func (c *Client) CreateNetwork(ctx context.Context, endpointID int, request types.NetworkCreateRequest) (*types.NetworkCreateResponse, error) {
+	var response types.NetworkCreateResponse
+	err := c.dockerRequest(ctx, endpointID, "POST", "/networks/create", request, &response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create network: %w", err)
+	}
+
+	return &response, nil
+}
+
+func (c *Client) RemoveNetwork(ctx context.Context, endpointID int, id string) error {
+	path := fmt.Sprintf("/networks/%s", url.PathEscape(id))
+
+	err := c.dockerRequest(ctx, endpointID, "DELETE", path, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to remove network: %w", err)
+	}
+
+	return nil
+}
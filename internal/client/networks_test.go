@@ -0,0 +1,70 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_ListNetworks_Stack(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/endpoints/1/docker/networks", r.URL.Path)
+		assert.Empty(t, r.URL.RawQuery)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[
+			{"Id":"n1","Name":"ingress","Driver":"overlay","Ingress":true},
+			{"Id":"n2","Name":"web_default","Driver":"overlay","Labels":{"com.docker.stack.namespace":"web"}},
+			{"Id":"n3","Name":"api_default","Driver":"bridge","Labels":{"com.docker.compose.project":"api"}}
+		]`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	networks, err := client.ListNetworks(context.Background(), 1, &types.NetworkFilters{Stack: "web"})
+
+	require.NoError(t, err)
+	require.Len(t, networks, 1)
+	assert.Equal(t, "web_default", networks[0].Name)
+}
+
+func TestClient_CreateNetwork(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/api/endpoints/1/docker/networks/create", r.URL.Path)
+
+		var request types.NetworkCreateRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		assert.Equal(t, "shared-proxy", request.Name)
+		assert.Equal(t, "overlay", request.Driver)
+		assert.True(t, request.Attachable)
+		require.NotNil(t, request.IPAM)
+		assert.Equal(t, "10.10.0.0/24", request.IPAM.Config[0].Subnet)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"Id":"7d86d31b1478","Warning":""}`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	response, err := client.CreateNetwork(context.Background(), 1, types.NetworkCreateRequest{
+		Name:       "shared-proxy",
+		Driver:     "overlay",
+		Attachable: true,
+		IPAM:       &types.NetworkIPAM{Config: []types.NetworkIPAMConfig{{Subnet: "10.10.0.0/24"}}},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "7d86d31b1478", response.ID)
+}
@@ -0,0 +1,132 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+)
+
+type NodeSpecMutator func(spec *types.NodeSpec) error
+
+func (c *Client) ListNodes(ctx context.Context, endpointID int) ([]types.Node, error) {
+	var nodes []types.Node
+	err := c.dockerRequest(ctx, endpointID, "GET", "/nodes", nil, &nodes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	return nodes, nil
+}
+
+func (c *Client) InspectNode(ctx context.Context, endpointID int, nodeID string) (*types.Node, error) {
+	path := fmt.Sprintf("/nodes/%s", url.PathEscape(nodeID))
+
+	var node types.Node
+	err := c.dockerRequest(ctx, endpointID, "GET", path, nil, &node)
+	if err != nil {
+		return nil, fmt.Errorf("failed to inspect node: %w", err)
+	}
+
+	return &node, nil
+}
+
+func (c *Client) UpdateNode(ctx context.Context, endpointID int, nodeID string, mutators ...NodeSpecMutator) error {
+	var err error
+	for attempt := 0; attempt < serviceUpdateAttempts; attempt++ {
+		err = c.updateNodeOnce(ctx, endpointID, nodeID, mutators)
+		if err == nil || !isOutOfSequence(err) {
+			break
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update node: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) updateNodeOnce(ctx context.Context, endpointID int, nodeID string, mutators []NodeSpecMutator) error {
+	node, err := c.InspectNode(ctx, endpointID, nodeID)
+	if err != nil {
+		return err
+	}
+
+	// NodeSpec is fully modelled, so unlike services it can be sent back as is
+	spec := node.Spec
+	for _, mutate := range mutators {
+		if err := mutate(&spec); err != nil {
+			return err
+		}
+	}
+
+	path := fmt.Sprintf("/nodes/%s/update?version=%d", url.PathEscape(node.ID), node.Version.Index)
+	return c.dockerRequest(ctx, endpointID, "POST", path, spec, nil)
+}
+
+func (c *Client) CountRunningTasks(ctx context.Context, endpointID int, nodeID string) (int, error) {
+	tasks, err := c.ListTasks(ctx, endpointID, &types.TaskFilters{Node: nodeID})
+	if err != nil {
+		return 0, err
+	}
+
+	running := 0
+	for _, task := range tasks {
+		if task.Status.State == "running" {
+			running++
+		}
+	}
+
+	return running, nil
+}
+
+func (c *Client) WaitForNodeDrain(ctx context.Context, endpointID int, nodeID string, pollInterval time.Duration) error {
+	for {
+		running, err := c.CountRunningTasks(ctx, endpointID, nodeID)
+		if err != nil {
+			return err
+		}
+		if running == 0 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for node %s to drain, %d tasks still running: %w", nodeID, running, ctx.Err())
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+func SetNodeAvailability(availability types.NodeAvailability) NodeSpecMutator {
+	return func(spec *types.NodeSpec) error {
+		spec.Availability = availability
+		return nil
+	}
+}
+
+func SetNodeLabels(labels map[string]string) NodeSpecMutator {
+	return func(spec *types.NodeSpec) error {
+		if spec.Labels == nil {
+			spec.Labels = map[string]string{}
+		}
+		for key, value := range labels {
+			spec.Labels[key] = value
+		}
+		return nil
+	}
+}
+
+func RemoveNodeLabels(keys []string) NodeSpecMutator {
+	return func(spec *types.NodeSpec) error {
+		for _, key := range keys {
+			if _, ok := spec.Labels[key]; !ok {
+				return fmt.Errorf("label %q not found on node", key)
+			}
+			delete(spec.Labels, key)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,92 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testNodeJSON = `{
+  "ID": "node2",
+  "Version": {"Index": 17},
+  "Spec": {"Labels": {"zone": "a", "storage": "ssd"}, "Role": "worker", "Availability": "active"},
+  "Description": {"Hostname": "worker-2"},
+  "Status": {"State": "ready", "Addr": "10.0.0.2"}
+}`
+
+func TestClient_UpdateNode_Drain(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/endpoints/1/docker/nodes/worker-2":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(testNodeJSON))
+		case r.Method == "POST" && r.URL.Path == "/api/endpoints/1/docker/nodes/node2/update":
+			assert.Equal(t, "17", r.URL.Query().Get("version"))
+
+			var spec types.NodeSpec
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&spec))
+			assert.Equal(t, types.NodeAvailabilityDrain, spec.Availability)
+			assert.Equal(t, "worker", spec.Role)
+			assert.Equal(t, map[string]string{"zone": "a", "storage": "ssd"}, spec.Labels)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	err := client.UpdateNode(context.Background(), 1, "worker-2", SetNodeAvailability(types.NodeAvailabilityDrain))
+
+	require.NoError(t, err)
+}
+
+func TestClient_UpdateNode_RemoveMissingLabel(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Errorf("unexpected update request")
+		}
+		w.Write([]byte(testNodeJSON))
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	err := client.UpdateNode(context.Background(), 1, "worker-2", RemoveNodeLabels([]string{"gpu"}))
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `label "gpu" not found`)
+}
+
+func TestClient_WaitForNodeDrain(t *testing.T) {
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/endpoints/1/docker/tasks", r.URL.Path)
+		assert.JSONEq(t, `{"node":["worker-2"]}`, r.URL.Query().Get("filters"))
+
+		polls++
+		if polls == 1 {
+			w.Write([]byte(`[{"ID":"t1","Status":{"State":"running"}},{"ID":"t2","Status":{"State":"shutdown"}}]`))
+			return
+		}
+		w.Write([]byte(`[{"ID":"t1","Status":{"State":"shutdown"}},{"ID":"t2","Status":{"State":"shutdown"}}]`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	err := client.WaitForNodeDrain(context.Background(), 1, "worker-2", time.Millisecond)
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, polls)
+}
@@ -0,0 +1,68 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+)
+
+func (c *Client) ListRegistries(ctx context.Context) ([]types.Registry, error) {
+	var registries []types.Registry
+	err := c.doRequest(ctx, "GET", "/api/registries", nil, &registries)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list registries: %w", err)
+	}
+
+	return registries, nil
+}
+
+func (c *Client) GetRegistry(ctx context.Context, registryID int) (*types.Registry, error) {
+	var registry types.Registry
+	err := c.doRequest(ctx, "GET", fmt.Sprintf("/api/registries/%d", registryID), nil, &registry)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get registry: %w", err)
+	}
+
+	return &registry, nil
+}
+
+func (c *Client) CreateRegistry(ctx context.Context, request types.RegistryCreateRequest) (*types.Registry, error) {
+	var registry types.Registry
+	err := c.doRequest(ctx, "POST", "/api/registries", request, &registry)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create registry: %w", err)
+	}
+
+	return &registry, nil
+}
+
+func (c *Client) UpdateRegistry(ctx context.Context, registryID int, request types.RegistryUpdateRequest) (*types.Registry, error) {
+	var registry types.Registry
+	err := c.doRequest(ctx, "PUT", fmt.Sprintf("/api/registries/%d", registryID), request, &registry)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update registry: %w", err)
+	}
+
+	return &registry, nil
+}
+
+func (c *Client) DeleteRegistry(ctx context.Context, registryID int) error {
+	err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/api/registries/%d", registryID), nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete registry: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) UpdateEndpointRegistryAccess(ctx context.Context, endpointID int, registryID int, policies types.RegistryAccessPolicies) error {
+	path := fmt.Sprintf("/api/endpoints/%d/registries/%d", endpointID, registryID)
+
+	err := c.doRequest(ctx, "PUT", path, policies, nil)
+	if err != nil {
+		return fmt.Errorf("failed to update registry access: %w", err)
+	}
+
+	return nil
+}
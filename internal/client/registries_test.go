@@ -0,0 +1,96 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_CreateRegistry(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/api/registries", r.URL.Path)
+
+		var request types.RegistryCreateRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		assert.Equal(t, types.RegistryTypeECR, request.Type)
+		assert.True(t, request.Authentication)
+		assert.Equal(t, "secret", request.Password)
+		require.NotNil(t, request.Ecr)
+		assert.Equal(t, "eu-west-1", request.Ecr.Region)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"Id":4,"Name":"ecr-prod","Type":7}`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	registry, err := client.CreateRegistry(context.Background(), types.RegistryCreateRequest{
+		Name:           "ecr-prod",
+		Type:           types.RegistryTypeECR,
+		URL:            "123456789012.dkr.ecr.eu-west-1.amazonaws.com",
+		Authentication: true,
+		Username:       "AKIA",
+		Password:       "secret",
+		Ecr:            &types.RegistryEcrData{Region: "eu-west-1"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 4, registry.ID)
+}
+
+func TestClient_UpdateRegistry_OnlySendsChangedFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		assert.Equal(t, "/api/registries/4", r.URL.Path)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"Name":"ecr-production"}`, string(body))
+
+		w.Write([]byte(`{"Id":4,"Name":"ecr-production","Type":7}`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	name := "ecr-production"
+	registry, err := client.UpdateRegistry(context.Background(), 4, types.RegistryUpdateRequest{Name: &name})
+
+	require.NoError(t, err)
+	assert.Equal(t, "ecr-production", registry.Name)
+}
+
+func TestClient_UpdateEndpointRegistryAccess(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		assert.Equal(t, "/api/endpoints/2/registries/4", r.URL.Path)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"UserAccessPolicies":{"5":{"RoleId":0}},"TeamAccessPolicies":{}}`, string(body))
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	err := client.UpdateEndpointRegistryAccess(context.Background(), 2, 4, types.RegistryAccessPolicies{
+		UserAccessPolicies: map[int]types.AccessPolicy{5: {}},
+		TeamAccessPolicies: map[int]types.AccessPolicy{},
+	})
+
+	require.NoError(t, err)
+}
@@ -0,0 +1,28 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+)
+
+func (c *Client) CreateResourceControl(ctx context.Context, request types.ResourceControlCreateRequest) (*types.ResourceControl, error) {
+	var rc types.ResourceControl
+	err := c.doRequest(ctx, "POST", "/api/resource_controls", request, &rc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create resource control: %w", err)
+	}
+
+	return &rc, nil
+}
+
+func (c *Client) UpdateResourceControl(ctx context.Context, id int, request types.ResourceControlUpdateRequest) (*types.ResourceControl, error) {
+	var rc types.ResourceControl
+	err := c.doRequest(ctx, "PUT", fmt.Sprintf("/api/resource_controls/%d", id), request, &rc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update resource control: %w", err)
+	}
+
+	return &rc, nil
+}
@@ -0,0 +1,66 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_CreateResourceControl_Stack(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/api/resource_controls", r.URL.Path)
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "2_shop", body["ResourceID"])
+		assert.Equal(t, "stack", body["Type"])
+		assert.Equal(t, []interface{}{float64(4)}, body["Teams"])
+		assert.Equal(t, []interface{}{}, body["Users"])
+
+		w.Write([]byte(`{"Id":9,"ResourceId":"2_shop","Type":6,"TeamAccesses":[{"TeamId":4,"AccessLevel":1}]}`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	rc, err := client.CreateResourceControl(context.Background(), types.ResourceControlCreateRequest{
+		ResourceID: types.StackResourceID(2, "shop"),
+		Type:       "stack",
+		Users:      []int{},
+		Teams:      []int{4},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 9, rc.ID)
+	assert.Equal(t, "restricted", rc.Ownership())
+}
+
+func TestClient_UpdateResourceControl_Public(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		assert.Equal(t, "/api/resource_controls/9", r.URL.Path)
+
+		var req types.ResourceControlUpdateRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.True(t, req.Public)
+
+		w.Write([]byte(`{"Id":9,"Public":true}`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	rc, err := client.UpdateResourceControl(context.Background(), 9, types.ResourceControlUpdateRequest{Public: true, Users: []int{}, Teams: []int{}})
+
+	require.NoError(t, err)
+	assert.Equal(t, "public", rc.Ownership())
+}
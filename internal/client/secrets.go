@@ -0,0 +1,95 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+)
+
+func (c *Client) ListSecrets(ctx context.Context, endpointID int) ([]types.Secret, error) {
+	var secrets []types.Secret
+	err := c.dockerRequest(ctx, endpointID, "GET", "/secrets", nil, &secrets)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list secrets: %w", err)
+	}
+
+	return secrets, nil
+}
+
+func (c *Client) InspectSecret(ctx context.Context, endpointID int, id string) (*types.Secret, error) {
+	path := fmt.Sprintf("/secrets/%s", url.PathEscape(id))
+
+	var secret types.Secret
+	err := c.dockerRequest(ctx, endpointID, "GET", path, nil, &secret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to inspect secret: %w", err)
+	}
+
+	return &secret, nil
+}
+
+func (c *Client) CreateSecret(ctx context.Context, endpointID int, spec types.SecretSpec) (string, error) {
+	var response types.IDResponse
+	err := c.dockerRequest(ctx, endpointID, "POST", "/secrets/create", spec, &response)
+	if err != nil {
+		return "", fmt.Errorf("failed to create secret: %w", err)
+	}
+
+	return response.ID, nil
+}
+
+func (c *Client) RemoveSecret(ctx context.Context, endpointID int, id string) error {
+	path := fmt.Sprintf("/secrets/%s", url.PathEscape(id))
+
+	err := c.dockerRequest(ctx, endpointID, "DELETE", path, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to remove secret: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) ListConfigs(ctx context.Context, endpointID int) ([]types.SwarmConfig, error) {
+	var configs []types.SwarmConfig
+	err := c.dockerRequest(ctx, endpointID, "GET", "/configs", nil, &configs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list configs: %w", err)
+	}
+
+	return configs, nil
+}
+
+func (c *Client) InspectConfig(ctx context.Context, endpointID int, id string) (*types.SwarmConfig, error) {
+	path := fmt.Sprintf("/configs/%s", url.PathEscape(id))
+
+	var config types.SwarmConfig
+	err := c.dockerRequest(ctx, endpointID, "GET", path, nil, &config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to inspect config: %w", err)
+	}
+
+	return &config, nil
+}
+
+func (c *Client) CreateConfig(ctx context.Context, endpointID int, spec types.SwarmConfigSpec) (string, error) {
+	var response types.IDResponse
+	err := c.dockerRequest(ctx, endpointID, "POST", "/configs/create", spec, &response)
+	if err != nil {
+		return "", fmt.Errorf("failed to create config: %w", err)
+	}
+
+	return response.ID, nil
+}
+
+func (c *Client) RemoveConfig(ctx context.Context, endpointID int, id string) error {
+	path := fmt.Sprintf("/configs/%s", url.PathEscape(id))
+
+	err := c.dockerRequest(ctx, endpointID, "DELETE", path, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to remove config: %w", err)
+	}
+
+	return nil
+}
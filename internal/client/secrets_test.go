@@ -0,0 +1,56 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_CreateSecret_EncodesData(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/api/endpoints/1/docker/secrets/create", r.URL.Path)
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "db_password", body["Name"])
+		assert.Equal(t, "czNjcjN0", body["Data"])
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"ID":"ktnbjxoalbkvbvedmg1urrz8h"}`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	id, err := client.CreateSecret(context.Background(), 1, types.SecretSpec{Name: "db_password", Data: []byte("s3cr3t")})
+
+	require.NoError(t, err)
+	assert.Equal(t, "ktnbjxoalbkvbvedmg1urrz8h", id)
+}
+
+func TestClient_InspectConfig_DecodesData(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/endpoints/1/docker/configs/nginx_conf", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ID":"cfg1","Spec":{"Name":"nginx_conf","Data":"d29ya2VyX3Byb2Nlc3NlcyAyOw=="}}`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	config, err := client.InspectConfig(context.Background(), 1, "nginx_conf")
+
+	require.NoError(t, err)
+	assert.Equal(t, "worker_processes 2;", string(config.Spec.Data))
+}
@@ -0,0 +1,271 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+)
+
+const serviceUpdateAttempts = 3
+
+type ServiceSpecMutator func(spec map[string]interface{}) error
+
+func (c *Client) ListServices(ctx context.Context, endpointID int, filters *types.ServiceFilters) ([]types.Service, error) {
+	params := url.Values{}
+	params.Set("status", "true")
+
+	dockerFilters := map[string][]string{}
+	if filters != nil && filters.Stack != "" {
+		dockerFilters["label"] = []string{types.StackNamespaceLabel + "=" + filters.Stack}
+	}
+	if err := encodeDockerFilters(params, dockerFilters); err != nil {
+		return nil, err
+	}
+
+	var services []types.Service
+	err := c.dockerRequest(ctx, endpointID, "GET", withQuery("/services", params), nil, &services)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list services: %w", err)
+	}
+
+	return services, nil
+}
+
+func (c *Client) InspectService(ctx context.Context, endpointID int, serviceID string) (*types.Service, error) {
+	path := fmt.Sprintf("/services/%s", url.PathEscape(serviceID))
+
+	var service types.Service
+	err := c.dockerRequest(ctx, endpointID, "GET", path, nil, &service)
+	if err != nil {
+		return nil, fmt.Errorf("failed to inspect service: %w", err)
+	}
+
+	return &service, nil
+}
+
+func (c *Client) ListTasks(ctx context.Context, endpointID int, filters *types.TaskFilters) ([]types.Task, error) {
+	params := url.Values{}
+
+	dockerFilters := map[string][]string{}
+	if filters != nil {
+		if filters.Service != "" {
+			dockerFilters["service"] = []string{filters.Service}
+		}
+		if filters.Node != "" {
+			dockerFilters["node"] = []string{filters.Node}
+		}
+		if filters.DesiredState != "" {
+			dockerFilters["desired-state"] = []string{filters.DesiredState}
+		}
+	}
+	if err := encodeDockerFilters(params, dockerFilters); err != nil {
+		return nil, err
+	}
+
+	var tasks []types.Task
+	err := c.dockerRequest(ctx, endpointID, "GET", withQuery("/tasks", params), nil, &tasks)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	return tasks, nil
+}
+
+func (c *Client) UpdateService(ctx context.Context, endpointID int, serviceID string, mutators ...ServiceSpecMutator) error {
+	var err error
+	for attempt := 0; attempt < serviceUpdateAttempts; attempt++ {
+		err = c.updateServiceOnce(ctx, endpointID, serviceID, mutators)
+		if err == nil || !isOutOfSequence(err) {
+			break
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update service: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) updateServiceOnce(ctx context.Context, endpointID int, serviceID string, mutators []ServiceSpecMutator) error {
+	path := fmt.Sprintf("/services/%s", url.PathEscape(serviceID))
+
+	// The spec is kept as a generic document so fields this CLI does not
+	// model survive the round trip.
+	var raw json.RawMessage
+	if err := c.dockerRequest(ctx, endpointID, "GET", path, nil, &raw); err != nil {
+		return err
+	}
+
+	var service struct {
+		ID      string                 `json:"ID"`
+		Version types.ObjectVersion    `json:"Version"`
+		Spec    map[string]interface{} `json:"Spec"`
+	}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	if err := decoder.Decode(&service); err != nil {
+		return fmt.Errorf("failed to decode service: %w", err)
+	}
+
+	for _, mutate := range mutators {
+		if err := mutate(service.Spec); err != nil {
+			return err
+		}
+	}
+
+	updatePath := fmt.Sprintf("/services/%s/update?version=%d", url.PathEscape(service.ID), service.Version.Index)
+	return c.dockerRequest(ctx, endpointID, "POST", updatePath, service.Spec, nil)
+}
+
+func (c *Client) WaitForService(ctx context.Context, endpointID int, serviceID string, previousUpdate string, pollInterval time.Duration) error {
+	for {
+		converged, err := c.serviceConverged(ctx, endpointID, serviceID, previousUpdate)
+		if err != nil {
+			// The deadline may expire during a request as well as between polls
+			if ctx.Err() != nil {
+				return fmt.Errorf("timed out waiting for service %s to converge: %w", serviceID, ctx.Err())
+			}
+			return err
+		}
+		if converged {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for service %s to converge: %w", serviceID, ctx.Err())
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+func (c *Client) serviceConverged(ctx context.Context, endpointID int, serviceID string, previousUpdate string) (bool, error) {
+	service, err := c.InspectService(ctx, endpointID, serviceID)
+	if err != nil {
+		return false, err
+	}
+
+	if isNewServiceUpdate(service.UpdateStatus, previousUpdate) {
+		switch service.UpdateStatus.State {
+		case "updating", "rollback_started":
+			return false, nil
+		case "paused", "rollback_paused":
+			return false, fmt.Errorf("update of service %s paused: %s", service.Spec.Name, service.UpdateStatus.Message)
+		case "rollback_completed":
+			return false, fmt.Errorf("update of service %s rolled back: %s", service.Spec.Name, service.UpdateStatus.Message)
+		}
+	}
+
+	tasks, err := c.ListTasks(ctx, endpointID, &types.TaskFilters{Service: service.ID, DesiredState: "running"})
+	if err != nil {
+		return false, err
+	}
+
+	running := uint64(0)
+	for _, task := range tasks {
+		upToDate := task.Spec.ContainerSpec.Image == service.Spec.TaskTemplate.ContainerSpec.Image &&
+			task.Spec.ForceUpdate == service.Spec.TaskTemplate.ForceUpdate
+		if task.Status.State != "running" || !upToDate {
+			return false, nil
+		}
+		running++
+	}
+
+	if replicated := service.Spec.Mode.Replicated; replicated != nil && replicated.Replicas != nil {
+		return running == *replicated.Replicas, nil
+	}
+
+	return true, nil
+}
+
+func isNewServiceUpdate(status *types.ServiceUpdateStatus, previousUpdate string) bool {
+	// Docker keeps the status of the last rolling update until the next one, so
+	// only a status whose start differs from the one recorded before the change
+	// belongs to it. Both values come from the server, the local clock may be off.
+	return status != nil && status.StartedAt != "" && status.StartedAt != previousUpdate
+}
+
+func SetServiceReplicas(replicas uint64) ServiceSpecMutator {
+	return func(spec map[string]interface{}) error {
+		mode := nestedMap(spec, "Mode")
+		replicated, ok := mode["Replicated"].(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("service is not in replicated mode and cannot be scaled")
+		}
+		replicated["Replicas"] = replicas
+		return nil
+	}
+}
+
+func SetServiceImage(image string) ServiceSpecMutator {
+	return func(spec map[string]interface{}) error {
+		nestedMap(spec, "TaskTemplate", "ContainerSpec")["Image"] = image
+		return nil
+	}
+}
+
+func ForceServiceUpdate() ServiceSpecMutator {
+	return func(spec map[string]interface{}) error {
+		taskTemplate := nestedMap(spec, "TaskTemplate")
+
+		current := uint64(0)
+		if value, ok := taskTemplate["ForceUpdate"].(json.Number); ok {
+			parsed, err := strconv.ParseUint(value.String(), 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid ForceUpdate counter: %w", err)
+			}
+			current = parsed
+		}
+		taskTemplate["ForceUpdate"] = current + 1
+		return nil
+	}
+}
+
+func ReplaceServiceSecret(oldSecretIDs []string, secretID string, secretName string) ServiceSpecMutator {
+	return func(spec map[string]interface{}) error {
+		old := make(map[string]bool, len(oldSecretIDs))
+		for _, id := range oldSecretIDs {
+			old[id] = true
+		}
+
+		// The File target is left untouched so the secret keeps its path in the containers
+		references, _ := nestedMap(spec, "TaskTemplate", "ContainerSpec")["Secrets"].([]interface{})
+		for _, reference := range references {
+			secret, ok := reference.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if id, _ := secret["SecretID"].(string); old[id] {
+				secret["SecretID"] = secretID
+				secret["SecretName"] = secretName
+			}
+		}
+		return nil
+	}
+}
+
+func nestedMap(document map[string]interface{}, keys ...string) map[string]interface{} {
+	current := document
+	for _, key := range keys {
+		next, ok := current[key].(map[string]interface{})
+		if !ok {
+			next = map[string]interface{}{}
+			current[key] = next
+		}
+		current = next
+	}
+	return current
+}
+
+func isOutOfSequence(err error) bool {
+	var httpErr *HTTPError
+	return errors.As(err, &httpErr) && strings.Contains(httpErr.Message, "out of sequence")
+}
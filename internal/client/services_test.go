@@ -0,0 +1,271 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testServiceJSON = `{
+  "ID": "svc1",
+  "Version": {"Index": 42},
+  "Spec": {
+    "Name": "shop_web",
+    "Labels": {"com.docker.stack.namespace": "shop"},
+    "TaskTemplate": {
+      "ContainerSpec": {"Image": "nginx:1.25@sha256:abc", "Secrets": [{"SecretName": "db"}]},
+      "Resources": {"Limits": {"NanoCPUs": 500000000}},
+      "ForceUpdate": 3
+    },
+    "Mode": {"Replicated": {"Replicas": 2}}
+  }
+}`
+
+func TestClient_ListServices_WithStackFilter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/endpoints/1/docker/services", r.URL.Path)
+		assert.Equal(t, "true", r.URL.Query().Get("status"))
+		assert.JSONEq(t, `{"label":["com.docker.stack.namespace=shop"]}`, r.URL.Query().Get("filters"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[` + testServiceJSON + `]`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	services, err := client.ListServices(context.Background(), 1, &types.ServiceFilters{Stack: "shop"})
+
+	require.NoError(t, err)
+	require.Len(t, services, 1)
+	assert.Equal(t, "shop_web", services[0].Spec.Name)
+	assert.Equal(t, "replicated", services[0].Mode())
+	assert.Equal(t, "nginx:1.25", services[0].Image())
+	assert.Equal(t, "shop", services[0].StackName())
+}
+
+func TestClient_UpdateService_ScaleKeepsUnknownFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/endpoints/1/docker/services/shop_web":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(testServiceJSON))
+		case r.Method == "POST" && r.URL.Path == "/api/endpoints/1/docker/services/svc1/update":
+			assert.Equal(t, "42", r.URL.Query().Get("version"))
+
+			var spec map[string]interface{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&spec))
+			assert.Equal(t, float64(5), spec["Mode"].(map[string]interface{})["Replicated"].(map[string]interface{})["Replicas"])
+
+			taskTemplate := spec["TaskTemplate"].(map[string]interface{})
+			assert.Equal(t, float64(500000000), taskTemplate["Resources"].(map[string]interface{})["Limits"].(map[string]interface{})["NanoCPUs"])
+			assert.Len(t, taskTemplate["ContainerSpec"].(map[string]interface{})["Secrets"], 1)
+
+			w.Write([]byte(`{"Warnings":null}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	err := client.UpdateService(context.Background(), 1, "shop_web", SetServiceReplicas(5))
+
+	require.NoError(t, err)
+}
+
+func TestClient_UpdateService_RetriesOutOfSequence(t *testing.T) {
+	updates := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			w.Write([]byte(testServiceJSON))
+			return
+		}
+
+		updates++
+		if updates == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"message":"rpc error: code = Unknown desc = update out of sequence"}`))
+			return
+		}
+
+		var spec map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&spec))
+		assert.Equal(t, float64(4), spec["TaskTemplate"].(map[string]interface{})["ForceUpdate"])
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	err := client.UpdateService(context.Background(), 1, "shop_web", ForceServiceUpdate())
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, updates)
+}
+
+func TestClient_UpdateService_ScaleGlobalServiceFails(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Errorf("unexpected update request")
+		}
+		w.Write([]byte(`{"ID":"svc2","Version":{"Index":1},"Spec":{"Name":"agent","Mode":{"Global":{}}}}`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	err := client.UpdateService(context.Background(), 1, "agent", SetServiceReplicas(2))
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not in replicated mode")
+}
+
+func TestClient_WaitForService_Converged(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/endpoints/1/docker/services/shop_web":
+			w.Write([]byte(testServiceJSON))
+		case "/api/endpoints/1/docker/tasks":
+			task := `{"ID":"%s","ServiceID":"svc1","DesiredState":"running","Status":{"State":"running"},"Spec":{"ContainerSpec":{"Image":"nginx:1.25@sha256:abc"},"ForceUpdate":3}}`
+			w.Write([]byte(`[` + fmt.Sprintf(task, "t1") + `,` + fmt.Sprintf(task, "t2") + `]`))
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	err := client.WaitForService(ctx, 1, "shop_web", "", 10*time.Millisecond)
+
+	require.NoError(t, err)
+}
+
+func rolledBackServiceServer(t *testing.T, startedAt time.Time) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/endpoints/1/docker/services/shop_web":
+			fmt.Fprintf(w, `{"ID":"svc1","Spec":{"Name":"shop_web","TaskTemplate":{"ContainerSpec":{"Image":"nginx:1.25"}}},"UpdateStatus":{"State":"rollback_completed","StartedAt":%q,"Message":"update rolled back due to failure"}}`,
+				startedAt.UTC().Format(time.RFC3339Nano))
+		case "/api/endpoints/1/docker/tasks":
+			w.Write([]byte(`[{"ID":"t1","ServiceID":"svc1","DesiredState":"running","Status":{"State":"running"},"Spec":{"ContainerSpec":{"Image":"nginx:1.25"}}}]`))
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+}
+
+func TestClient_WaitForService_RolledBack(t *testing.T) {
+	// The manager's clock is behind the client's: the rollback of this update
+	// started "an hour ago" from the client's point of view
+	previousUpdate := time.Now().Add(-2 * time.Hour).UTC().Format(time.RFC3339Nano)
+	server := rolledBackServiceServer(t, time.Now().Add(-time.Hour))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	err := client.WaitForService(context.Background(), 1, "shop_web", previousUpdate, 10*time.Millisecond)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "rolled back")
+}
+
+func TestClient_WaitForService_IgnoresEarlierUpdateStatus(t *testing.T) {
+	startedAt := time.Now().Add(time.Hour)
+	server := rolledBackServiceServer(t, startedAt)
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	// A rollback left by an earlier deploy or seen before a scale-only change,
+	// even when the manager's clock is ahead of the client's
+	previousUpdate := startedAt.UTC().Format(time.RFC3339Nano)
+	require.NoError(t, client.WaitForService(ctx, 1, "shop_web", previousUpdate, 10*time.Millisecond))
+}
+
+func TestClient_WaitForService_TimesOutWhileUpdating(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The deadline expires while the update is still running
+		cancel()
+		fmt.Fprintf(w, `{"ID":"svc1","Spec":{"Name":"shop_web"},"UpdateStatus":{"State":"updating","StartedAt":%q}}`,
+			time.Now().Add(time.Hour).UTC().Format(time.RFC3339Nano))
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	err := client.WaitForService(ctx, 1, "shop_web", "", 10*time.Millisecond)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "timed out")
+}
+
+func TestClient_WaitForService_TimesOutDuringRequest(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The deadline expires before the server answers
+		cancel()
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	err := client.WaitForService(ctx, 1, "shop_web", "", 10*time.Millisecond)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "timed out waiting for service shop_web")
+}
+
+func TestReplaceServiceSecret(t *testing.T) {
+	spec := map[string]interface{}{
+		"TaskTemplate": map[string]interface{}{
+			"ContainerSpec": map[string]interface{}{
+				"Secrets": []interface{}{
+					map[string]interface{}{"SecretID": "old1", "SecretName": "db_password", "File": map[string]interface{}{"Name": "db_password"}},
+					map[string]interface{}{"SecretID": "tls", "SecretName": "tls_key"},
+				},
+			},
+		},
+	}
+
+	err := ReplaceServiceSecret([]string{"old1", "old2"}, "new3", "db_password.v3")(spec)
+
+	require.NoError(t, err)
+	secrets := spec["TaskTemplate"].(map[string]interface{})["ContainerSpec"].(map[string]interface{})["Secrets"].([]interface{})
+	rotated := secrets[0].(map[string]interface{})
+	assert.Equal(t, "new3", rotated["SecretID"])
+	assert.Equal(t, "db_password.v3", rotated["SecretName"])
+	assert.Equal(t, "db_password", rotated["File"].(map[string]interface{})["Name"])
+	assert.Equal(t, "tls", secrets[1].(map[string]interface{})["SecretID"])
+}
@@ -0,0 +1,28 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+)
+
+func (c *Client) GetSettings(ctx context.Context) (types.Settings, error) {
+	var settings types.Settings
+	err := c.doRequest(ctx, "GET", "/api/settings", nil, &settings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get settings: %w", err)
+	}
+
+	return settings, nil
+}
+
+func (c *Client) UpdateSettings(ctx context.Context, settings types.Settings) (types.Settings, error) {
+	var updated types.Settings
+	err := c.doRequest(ctx, "PUT", "/api/settings", settings, &updated)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update settings: %w", err)
+	}
+
+	return updated, nil
+}
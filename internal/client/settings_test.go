@@ -0,0 +1,39 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_UpdateSettings(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		assert.Equal(t, "/api/settings", r.URL.Path)
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "10m", body["SnapshotInterval"])
+		assert.Equal(t, "kept", body["UnknownToTheCLI"])
+
+		w.Write([]byte(`{"SnapshotInterval":"10m","UnknownToTheCLI":"kept"}`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	updated, err := client.UpdateSettings(context.Background(), types.Settings{
+		"SnapshotInterval": "10m",
+		"UnknownToTheCLI":  "kept",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "10m", updated["SnapshotInterval"])
+}
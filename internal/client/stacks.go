@@ -43,6 +43,18 @@ func (c *Client) CreateSwarmStackFromGit(ctx context.Context, endpointID int, pa
 	return &stack, nil
 }
 
+func (c *Client) CreateComposeStackFromGit(ctx context.Context, endpointID int, payload types.StackCreateComposeGitPayload) (*types.Stack, error) {
+	path := fmt.Sprintf("/api/stacks/create/standalone/repository?endpointId=%d", endpointID)
+
+	var stack types.Stack
+	err := c.doRequest(ctx, "POST", path, payload, &stack)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create compose stack from git: %w", err)
+	}
+
+	return &stack, nil
+}
+
 func (c *Client) RedeployStackFromGit(ctx context.Context, stackID int, endpointID int, payload types.StackGitRedeployPayload) (*types.Stack, error) {
 	path := fmt.Sprintf("/api/stacks/%d/git/redeploy", stackID)
 	if endpointID > 0 {
@@ -57,3 +69,61 @@ func (c *Client) RedeployStackFromGit(ctx context.Context, stackID int, endpoint
 
 	return &stack, nil
 }
+
+func (c *Client) GetStack(ctx context.Context, stackID int) (*types.Stack, error) {
+	var stack types.Stack
+	err := c.doRequest(ctx, "GET", fmt.Sprintf("/api/stacks/%d", stackID), nil, &stack)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stack: %w", err)
+	}
+
+	return &stack, nil
+}
+
+func (c *Client) CreateKubernetesStackFromGit(ctx context.Context, endpointID int, payload types.StackCreateKubernetesGitPayload) (*types.Stack, error) {
+	path := fmt.Sprintf("/api/stacks/create/kubernetes/repository?endpointId=%d", endpointID)
+
+	var stack types.Stack
+	err := c.doRequest(ctx, "POST", path, payload, &stack)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kubernetes stack from git: %w", err)
+	}
+
+	return &stack, nil
+}
+
+func (c *Client) CreateKubernetesStackFromFile(ctx context.Context, endpointID int, payload types.StackCreateKubernetesFilePayload) (*types.Stack, error) {
+	path := fmt.Sprintf("/api/stacks/create/kubernetes/string?endpointId=%d", endpointID)
+
+	var stack types.Stack
+	err := c.doRequest(ctx, "POST", path, payload, &stack)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kubernetes stack from file: %w", err)
+	}
+
+	return &stack, nil
+}
+
+func (c *Client) CreateSwarmStackFromString(ctx context.Context, endpointID int, payload types.StackCreateSwarmStringPayload) (*types.Stack, error) {
+	path := fmt.Sprintf("/api/stacks/create/swarm/string?endpointId=%d", endpointID)
+
+	var stack types.Stack
+	err := c.doRequest(ctx, "POST", path, payload, &stack)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create swarm stack: %w", err)
+	}
+
+	return &stack, nil
+}
+
+func (c *Client) CreateComposeStackFromString(ctx context.Context, endpointID int, payload types.StackCreateComposeStringPayload) (*types.Stack, error) {
+	path := fmt.Sprintf("/api/stacks/create/standalone/string?endpointId=%d", endpointID)
+
+	var stack types.Stack
+	err := c.doRequest(ctx, "POST", path, payload, &stack)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create compose stack: %w", err)
+	}
+
+	return &stack, nil
+}
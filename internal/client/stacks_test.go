@@ -355,3 +355,33 @@ func TestClient_RedeployStackFromGit_PermissionDenied(t *testing.T) {
 	require.ErrorAs(t, err, &httpErr)
 	assert.Equal(t, 403, httpErr.StatusCode)
 }
+
+func TestClient_CreateKubernetesStackFromFile(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/api/stacks/create/kubernetes/string", r.URL.Path)
+		assert.Equal(t, "4", r.URL.Query().Get("endpointId"))
+
+		var payload types.StackCreateKubernetesFilePayload
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		assert.Equal(t, "shop", payload.StackName)
+		assert.Equal(t, "shop", payload.Namespace)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"Id":12,"Name":"shop","Type":3,"EndpointId":4,"Namespace":"shop"}`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	stack, err := client.CreateKubernetesStackFromFile(context.Background(), 4, types.StackCreateKubernetesFilePayload{
+		StackName:        "shop",
+		Namespace:        "shop",
+		StackFileContent: "apiVersion: v1\nkind: Namespace\n",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, types.StackTypeKubernetes, stack.Type)
+	assert.Equal(t, "kubernetes", stack.Type.String())
+}
@@ -0,0 +1,38 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+)
+
+func (c *Client) GetSystemStatus(ctx context.Context) (*types.SystemStatus, error) {
+	var status types.SystemStatus
+	err := c.doRequest(ctx, "GET", "/api/status", nil, &status)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get server status: %w", err)
+	}
+
+	return &status, nil
+}
+
+func (c *Client) GetSystemVersion(ctx context.Context) (*types.SystemVersion, error) {
+	var version types.SystemVersion
+	err := c.doRequest(ctx, "GET", "/api/system/version", nil, &version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get server version: %w", err)
+	}
+
+	return &version, nil
+}
+
+func (c *Client) GetSystemInfo(ctx context.Context) (*types.SystemInfo, error) {
+	var info types.SystemInfo
+	err := c.doRequest(ctx, "GET", "/api/system/info", nil, &info)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get system info: %w", err)
+	}
+
+	return &info, nil
+}
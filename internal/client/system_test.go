@@ -0,0 +1,46 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_GetSystemStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/status", r.URL.Path)
+		assert.Empty(t, r.Header.Get("Authorization"))
+		w.Write([]byte(`{"Version":"2.21.4","InstanceID":"9f1c2b"}`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+
+	status, err := client.GetSystemStatus(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, "2.21.4", status.Version)
+	assert.Equal(t, "9f1c2b", status.InstanceID)
+}
+
+func TestClient_GetSystemVersion(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/system/version", r.URL.Path)
+		w.Write([]byte(`{"ServerVersion":"2.21.4","ServerEdition":"CE","DatabaseVersion":"2.21.4","LatestVersion":"2.22.0","UpdateAvailable":true,"Build":{"GoVersion":"go1.22"}}`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	version, err := client.GetSystemVersion(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, "CE", version.ServerEdition)
+	assert.True(t, version.UpdateAvailable)
+	assert.Equal(t, "go1.22", version.Build.GoVersion)
+}
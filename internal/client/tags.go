@@ -0,0 +1,37 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+)
+
+func (c *Client) ListTags(ctx context.Context) ([]types.Tag, error) {
+	var tags []types.Tag
+	err := c.doRequest(ctx, "GET", "/api/tags", nil, &tags)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+
+	return tags, nil
+}
+
+func (c *Client) CreateTag(ctx context.Context, name string) (*types.Tag, error) {
+	var tag types.Tag
+	err := c.doRequest(ctx, "POST", "/api/tags", types.TagCreateRequest{Name: name}, &tag)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tag: %w", err)
+	}
+
+	return &tag, nil
+}
+
+func (c *Client) DeleteTag(ctx context.Context, tagID int) error {
+	err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/api/tags/%d", tagID), nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete tag: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+)
+
+func (c *Client) ListTeams(ctx context.Context) ([]types.Team, error) {
+	var teams []types.Team
+	err := c.doRequest(ctx, "GET", "/api/teams", nil, &teams)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list teams: %w", err)
+	}
+
+	return teams, nil
+}
+
+func (c *Client) CreateTeam(ctx context.Context, request types.TeamCreateRequest) (*types.Team, error) {
+	var team types.Team
+	err := c.doRequest(ctx, "POST", "/api/teams", request, &team)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create team: %w", err)
+	}
+
+	return &team, nil
+}
+
+func (c *Client) DeleteTeam(ctx context.Context, teamID int) error {
+	err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/api/teams/%d", teamID), nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete team: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) ListTeamMemberships(ctx context.Context) ([]types.TeamMembership, error) {
+	var memberships []types.TeamMembership
+	err := c.doRequest(ctx, "GET", "/api/team_memberships", nil, &memberships)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list team memberships: %w", err)
+	}
+
+	return memberships, nil
+}
+
+func (c *Client) CreateTeamMembership(ctx context.Context, request types.TeamMembershipCreateRequest) (*types.TeamMembership, error) {
+	var membership types.TeamMembership
+	err := c.doRequest(ctx, "POST", "/api/team_memberships", request, &membership)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create team membership: %w", err)
+	}
+
+	return &membership, nil
+}
+
+func (c *Client) UpdateTeamMembership(ctx context.Context, membershipID int, request types.TeamMembershipCreateRequest) (*types.TeamMembership, error) {
+	var membership types.TeamMembership
+	err := c.doRequest(ctx, "PUT", fmt.Sprintf("/api/team_memberships/%d", membershipID), request, &membership)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update team membership: %w", err)
+	}
+
+	return &membership, nil
+}
+
+func (c *Client) DeleteTeamMembership(ctx context.Context, membershipID int) error {
+	err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/api/team_memberships/%d", membershipID), nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete team membership: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+)
+
+func (c *Client) GetCurrentUser(ctx context.Context) (*types.User, error) {
+	var user types.User
+	err := c.doRequest(ctx, "GET", "/api/users/me", nil, &user)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current user: %w", err)
+	}
+
+	return &user, nil
+}
+
+func (c *Client) ListAPITokens(ctx context.Context, userID int) ([]types.APIToken, error) {
+	var tokens []types.APIToken
+	err := c.doRequest(ctx, "GET", fmt.Sprintf("/api/users/%d/tokens", userID), nil, &tokens)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list access tokens: %w", err)
+	}
+
+	return tokens, nil
+}
+
+func (c *Client) CreateAPIToken(ctx context.Context, userID int, request types.APITokenCreateRequest) (*types.APITokenCreateResponse, error) {
+	var resp types.APITokenCreateResponse
+	err := c.doRequest(ctx, "POST", fmt.Sprintf("/api/users/%d/tokens", userID), request, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create access token: %w", err)
+	}
+
+	if resp.RawAPIKey == "" {
+		return nil, fmt.Errorf("no access token received in response")
+	}
+
+	return &resp, nil
+}
+
+func (c *Client) RevokeAPIToken(ctx context.Context, userID int, tokenID int) error {
+	err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/api/users/%d/tokens/%d", userID, tokenID), nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to revoke access token: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_CreateAPIToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/api/users/3/tokens", r.URL.Path)
+
+		var req types.APITokenCreateRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "gitlab", req.Description)
+		assert.Equal(t, "secret", req.Password)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"rawAPIKey":"ptr_abc123","apiKey":{"id":7,"userId":3,"description":"gitlab","prefix":"ptr_abc"}}`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	resp, err := client.CreateAPIToken(context.Background(), 3, types.APITokenCreateRequest{Description: "gitlab", Password: "secret"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "ptr_abc123", resp.RawAPIKey)
+	assert.Equal(t, 7, resp.APIKey.ID)
+}
+
+func TestClient_APIKeyTakesPrecedenceOverToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "ptr_abc123", r.Header.Get("X-API-Key"))
+		assert.Empty(t, r.Header.Get("Authorization"))
+		w.Write([]byte(`{"Id":3,"Username":"ci-bot","Role":2}`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("stale-token")
+	client.SetAPIKey("ptr_abc123")
+
+	user, err := client.GetCurrentUser(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, "ci-bot", user.Username)
+}
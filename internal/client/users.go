@@ -0,0 +1,47 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+)
+
+func (c *Client) ListUsers(ctx context.Context) ([]types.User, error) {
+	var users []types.User
+	err := c.doRequest(ctx, "GET", "/api/users", nil, &users)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list users: %w", err)
+	}
+
+	return users, nil
+}
+
+func (c *Client) CreateUser(ctx context.Context, request types.UserCreateRequest) (*types.User, error) {
+	var user types.User
+	err := c.doRequest(ctx, "POST", "/api/users", request, &user)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create user: %w", err)
+	}
+
+	return &user, nil
+}
+
+func (c *Client) UpdateUser(ctx context.Context, userID int, request types.UserUpdateRequest) (*types.User, error) {
+	var user types.User
+	err := c.doRequest(ctx, "PUT", fmt.Sprintf("/api/users/%d", userID), request, &user)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update user: %w", err)
+	}
+
+	return &user, nil
+}
+
+func (c *Client) DeleteUser(ctx context.Context, userID int) error {
+	err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/api/users/%d", userID), nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete user: %w", err)
+	}
+
+	return nil
+}
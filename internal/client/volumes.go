@@ -0,0 +1,78 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+)
+
+func (c *Client) ListVolumes(ctx context.Context, endpointID int, filters *types.VolumeFilters) ([]types.Volume, error) {
+	params := url.Values{}
+	dockerFilters := map[string][]string{}
+
+	if filters != nil && filters.Dangling {
+		dockerFilters["dangling"] = []string{"true"}
+	}
+
+	if err := encodeDockerFilters(params, dockerFilters); err != nil {
+		return nil, err
+	}
+
+	var response types.VolumeListResponse
+	err := c.dockerRequest(ctx, endpointID, "GET", withQuery("/volumes", params), nil, &response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list volumes: %w", err)
+	}
+
+	volumes := response.Volumes
+	if filters != nil && filters.Stack != "" {
+		filtered := make([]types.Volume, 0, len(volumes))
+		for _, volume := range volumes {
+			if volume.StackName() == filters.Stack {
+				filtered = append(filtered, volume)
+			}
+		}
+		volumes = filtered
+	}
+
+	return volumes, nil
+}
+
+func (c *Client) InspectVolume(ctx context.Context, endpointID int, name string) (*types.Volume, error) {
+	path := fmt.Sprintf("/volumes/%s", url.PathEscape(name))
+
+	var volume types.Volume
+	err := c.dockerRequest(ctx, endpointID, "GET", path, nil, &volume)
+	if err != nil {
+		return nil, fmt.Errorf("failed to inspect volume: %w", err)
+	}
+
+	return &volume, nil
+}
+
+func (c *Client) CreateVolume(ctx context.Context, endpointID int, request types.VolumeCreateRequest) (*types.Volume, error) {
+	var volume types.Volume
+	err := c.dockerRequest(ctx, endpointID, "POST", "/volumes/create", request, &volume)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create volume: %w", err)
+	}
+
+	return &volume, nil
+}
+
+func (c *Client) RemoveVolume(ctx context.Context, endpointID int, name string, force bool) error {
+	params := url.Values{}
+	if force {
+		params.Set("force", "true")
+	}
+	path := withQuery(fmt.Sprintf("/volumes/%s", url.PathEscape(name)), params)
+
+	err := c.dockerRequest(ctx, endpointID, "DELETE", path, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to remove volume: %w", err)
+	}
+
+	return nil
+}
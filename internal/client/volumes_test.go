@@ -0,0 +1,54 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_ListVolumes_DanglingAndStack(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/endpoints/1/docker/volumes", r.URL.Path)
+		assert.JSONEq(t, `{"dangling":["true"]}`, r.URL.Query().Get("filters"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"Volumes":[
+			{"Name":"web_data","Driver":"local","Labels":{"com.docker.stack.namespace":"web"},"Portainer":{"Agent":{"NodeName":"worker-2"}}},
+			{"Name":"api_data","Driver":"local","Labels":{"com.docker.compose.project":"api"}},
+			{"Name":"3f2a1b9c8d7e","Driver":"local"}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	volumes, err := client.ListVolumes(context.Background(), 1, &types.VolumeFilters{Stack: "web", Dangling: true})
+
+	require.NoError(t, err)
+	require.Len(t, volumes, 1)
+	assert.Equal(t, "web_data", volumes[0].Name)
+	assert.Equal(t, "worker-2", volumes[0].NodeName())
+}
+
+func TestClient_RemoveVolume_Force(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, "/api/endpoints/1/docker/volumes/web_data", r.URL.Path)
+		assert.Equal(t, "true", r.URL.Query().Get("force"))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	err := client.RemoveVolume(context.Background(), 1, "web_data", true)
+
+	require.NoError(t, err)
+}
@@ -0,0 +1,81 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+)
+
+func (c *Client) BaseURL() string {
+	return c.baseURL
+}
+
+func (c *Client) ListWebhooks(ctx context.Context, filters *types.WebhookFilters) ([]types.Webhook, error) {
+	path := "/api/webhooks"
+	if filters != nil && (filters.EndpointID > 0 || filters.ResourceID != "") {
+		data, err := json.Marshal(filters)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode webhook filters: %w", err)
+		}
+		path = withQuery(path, url.Values{"filters": {string(data)}})
+	}
+
+	var webhooks []types.Webhook
+	err := c.doRequest(ctx, "GET", path, nil, &webhooks)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhooks: %w", err)
+	}
+
+	return webhooks, nil
+}
+
+func (c *Client) CreateWebhook(ctx context.Context, request types.WebhookCreateRequest) (*types.Webhook, error) {
+	var webhook types.Webhook
+	err := c.doRequest(ctx, "POST", "/api/webhooks", request, &webhook)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook: %w", err)
+	}
+
+	return &webhook, nil
+}
+
+func (c *Client) DeleteWebhook(ctx context.Context, webhookID int) error {
+	err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/api/webhooks/%d", webhookID), nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) TriggerWebhook(ctx context.Context, token string, tag string) error {
+	params := url.Values{}
+	if tag != "" {
+		params.Set("tag", tag)
+	}
+
+	err := c.doRequest(ctx, "POST", withQuery("/api/webhooks/"+url.PathEscape(token), params), nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to trigger webhook: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) TriggerStackWebhook(ctx context.Context, webhookID string, env []types.Pair) error {
+	// Portainer applies query parameters as environment variable overrides
+	params := url.Values{}
+	for _, pair := range env {
+		params.Set(pair.Name, pair.Value)
+	}
+
+	err := c.doRequest(ctx, "POST", withQuery("/api/stacks/webhooks/"+url.PathEscape(webhookID), params), nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to trigger stack webhook: %w", err)
+	}
+
+	return nil
+}
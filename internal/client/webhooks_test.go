@@ -0,0 +1,62 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_ListWebhooks_Filters(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/webhooks", r.URL.Path)
+		assert.Equal(t, `{"EndpointID":2}`, r.URL.Query().Get("filters"))
+		w.Write([]byte(`[{"Id":4,"Token":"tok","ResourceId":"svc1","EndpointId":2,"Type":1}]`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	client.SetToken("test-token")
+
+	webhooks, err := client.ListWebhooks(context.Background(), &types.WebhookFilters{EndpointID: 2})
+
+	require.NoError(t, err)
+	require.Len(t, webhooks, 1)
+	assert.Equal(t, types.WebhookTypeService, webhooks[0].Type)
+}
+
+func TestClient_TriggerStackWebhook_WithoutCredentials(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/api/stacks/webhooks/abc", r.URL.Path)
+		assert.Empty(t, r.Header.Get("Authorization"))
+		assert.Empty(t, r.Header.Get("X-API-Key"))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	err := New(server.URL).TriggerStackWebhook(context.Background(), "abc", nil)
+
+	require.NoError(t, err)
+}
+
+func TestClient_TriggerStackWebhook_EnvOverrides(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/stacks/webhooks/abc", r.URL.Path)
+		assert.Equal(t, "1.4.2", r.URL.Query().Get("VERSION"))
+		assert.Equal(t, "debug", r.URL.Query().Get("LOG_LEVEL"))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	err := New(server.URL).TriggerStackWebhook(context.Background(), "abc", []types.Pair{
+		{Name: "VERSION", Value: "1.4.2"},
+		{Name: "LOG_LEVEL", Value: "debug"},
+	})
+
+	require.NoError(t, err)
+}
@@ -14,11 +14,12 @@ const (
 )
 
 type Config struct {
-	ServerURL string `mapstructure:"server_url" yaml:"server_url"`
-	Username  string `mapstructure:"username" yaml:"username"`
-	Password  string `mapstructure:"password" yaml:"password"`
-	Token     string `mapstructure:"token" yaml:"token"`
-	APIKey    string `mapstructure:"api_key" yaml:"api_key"`
+	ServerURL       string `mapstructure:"server_url" yaml:"server_url"`
+	Username        string `mapstructure:"username" yaml:"username"`
+	Password        string `mapstructure:"password" yaml:"password"`
+	Token           string `mapstructure:"token" yaml:"token"`
+	APIKey          string `mapstructure:"api_key" yaml:"api_key"`
+	DefaultEndpoint string `mapstructure:"default_endpoint" yaml:"default_endpoint"`
 }
 
 func Load() (*Config, error) {
@@ -60,6 +61,7 @@ func Save(cfg *Config) error {
 	viper.Set("password", cfg.Password)
 	viper.Set("token", cfg.Token)
 	viper.Set("api_key", cfg.APIKey)
+	viper.Set("default_endpoint", cfg.DefaultEndpoint)
 
 	if err := viper.WriteConfigAs(configPath); err != nil {
 		return fmt.Errorf("failed to write config: %w", err)
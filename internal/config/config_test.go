@@ -19,11 +19,12 @@ func TestConfig_Load_Save(t *testing.T) {
 	defer os.Setenv("HOME", originalHome)
 
 	cfg := &Config{
-		ServerURL: "https://portainer.example.com",
-		Username:  "testuser",
-		Password:  "testpass",
-		APIKey:    "testkey",
-		Token:     "testtoken",
+		ServerURL:       "https://portainer.example.com",
+		Username:        "testuser",
+		Password:        "testpass",
+		APIKey:          "testkey",
+		Token:           "testtoken",
+		DefaultEndpoint: "prod-swarm",
 	}
 
 	err = Save(cfg)
@@ -37,6 +38,7 @@ func TestConfig_Load_Save(t *testing.T) {
 	assert.Equal(t, cfg.Password, loadedCfg.Password)
 	assert.Equal(t, cfg.APIKey, loadedCfg.APIKey)
 	assert.Equal(t, cfg.Token, loadedCfg.Token)
+	assert.Equal(t, cfg.DefaultEndpoint, loadedCfg.DefaultEndpoint)
 }
 
 func TestConfig_GetConfigPath(t *testing.T) {
@@ -0,0 +1,45 @@
+package printer
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+)
+
+func PrintBackupS3(details types.BackupS3Details, format string) error {
+	switch format {
+	case "json":
+		return printJSON(details)
+	case "yaml":
+		return printYAML(details)
+	default:
+		return printBackupS3Details(details)
+	}
+}
+
+func printBackupS3Details(details types.BackupS3Details) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	settings := details.Settings
+	fmt.Fprintf(w, "Bucket:\t%s\n", valueOrDash(settings.BucketName))
+	fmt.Fprintf(w, "Region:\t%s\n", valueOrDash(settings.Region))
+	fmt.Fprintf(w, "S3 Host:\t%s\n", valueOrDash(settings.S3CompatibleHost))
+	fmt.Fprintf(w, "Access Key ID:\t%s\n", valueOrDash(settings.AccessKeyID))
+	fmt.Fprintf(w, "Secret Access Key:\t%s\n", valueOrDash(settings.SecretAccessKey))
+	fmt.Fprintf(w, "Encrypted:\t%s\n", yesNo(settings.Password != ""))
+	fmt.Fprintf(w, "Schedule:\t%s\n", valueOrDash(settings.CronRule))
+
+	lastRun := "-"
+	if details.Status.TimestampUTC != "" {
+		result := "succeeded"
+		if details.Status.Failed {
+			result = "failed"
+		}
+		lastRun = fmt.Sprintf("%s (%s)", details.Status.TimestampUTC, result)
+	}
+	fmt.Fprintf(w, "Last Run:\t%s\n", lastRun)
+
+	return w.Flush()
+}
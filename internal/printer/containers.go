@@ -0,0 +1,79 @@
+package printer
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+)
+
+func PrintContainers(containers []types.Container, format string) error {
+	switch format {
+	case "json":
+		return printJSON(containers)
+	case "yaml":
+		return printYAML(containers)
+	default:
+		return printContainersTable(containers)
+	}
+}
+
+func PrintContainer(container types.ContainerDetails, format string) error {
+	switch format {
+	case "json":
+		return printJSON(container)
+	case "yaml":
+		return printYAML(container)
+	default:
+		return printContainerDetails(container)
+	}
+}
+
+func printContainersTable(containers []types.Container) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(w, "CONTAINER ID\tNAME\tIMAGE\tSTATE\tSTATUS\tSTACK")
+	fmt.Fprintln(w, "------------\t----\t-----\t-----\t------\t-----")
+
+	for _, container := range containers {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+			shortID(container.ID),
+			container.Name(),
+			truncate(container.Image, 40),
+			container.State,
+			container.Status,
+			valueOrDash(container.StackName()),
+		)
+	}
+
+	return w.Flush()
+}
+
+func printContainerDetails(container types.ContainerDetails) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintf(w, "ID:\t%s\n", container.ID)
+	fmt.Fprintf(w, "Name:\t%s\n", strings.TrimPrefix(container.Name, "/"))
+	fmt.Fprintf(w, "Image:\t%s\n", container.Config.Image)
+	fmt.Fprintf(w, "Created:\t%s\n", container.Created)
+	fmt.Fprintf(w, "State:\t%s\n", container.State.Status)
+	if container.State.Health != nil {
+		fmt.Fprintf(w, "Health:\t%s\n", container.State.Health.Status)
+	}
+	fmt.Fprintf(w, "Started At:\t%s\n", container.State.StartedAt)
+	if !container.State.Running {
+		fmt.Fprintf(w, "Finished At:\t%s\n", container.State.FinishedAt)
+		fmt.Fprintf(w, "Exit Code:\t%d\n", container.State.ExitCode)
+	}
+	if container.State.Error != "" {
+		fmt.Fprintf(w, "Error:\t%s\n", container.State.Error)
+	}
+	fmt.Fprintf(w, "Restart Count:\t%d\n", container.RestartCount)
+	fmt.Fprintf(w, "Stack:\t%s\n", valueOrDash(types.StackFromLabels(container.Config.Labels)))
+
+	printLabels(w, container.Config.Labels)
+
+	return w.Flush()
+}
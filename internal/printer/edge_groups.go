@@ -0,0 +1,76 @@
+package printer
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+)
+
+func PrintEdgeGroups(groups []types.EdgeGroupDetails, format string) error {
+	switch format {
+	case "json":
+		return printJSON(groups)
+	case "yaml":
+		return printYAML(groups)
+	default:
+		return printEdgeGroupsTable(groups)
+	}
+}
+
+func PrintEdgeJobs(jobs []types.EdgeJobDetails, format string) error {
+	switch format {
+	case "json":
+		return printJSON(jobs)
+	case "yaml":
+		return printYAML(jobs)
+	default:
+		return printEdgeJobsTable(jobs)
+	}
+}
+
+func printEdgeGroupsTable(groups []types.EdgeGroupDetails) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(w, "ID\tNAME\tTYPE\tTAGS\tENDPOINTS")
+	fmt.Fprintln(w, "--\t----\t----\t----\t---------")
+
+	for _, group := range groups {
+		tags := strings.Join(group.TagNames, ",")
+		if group.Dynamic && group.PartialMatch && tags != "" {
+			tags = "any of " + tags
+		}
+
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\n",
+			group.ID,
+			group.Name,
+			group.Kind(),
+			valueOrDash(tags),
+			valueOrDash(strings.Join(group.EndpointNames, ",")),
+		)
+	}
+
+	return w.Flush()
+}
+
+func printEdgeJobsTable(jobs []types.EdgeJobDetails) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(w, "ID\tNAME\tSCHEDULE\tRECURRING\tEDGE GROUPS\tCREATED")
+	fmt.Fprintln(w, "--\t----\t--------\t---------\t-----------\t-------")
+
+	for _, job := range jobs {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\n",
+			job.ID,
+			job.Name,
+			job.CronExpression,
+			yesNo(job.Recurring),
+			valueOrDash(strings.Join(job.EdgeGroupNames, ",")),
+			formatUnixTime(job.Created),
+		)
+	}
+
+	return w.Flush()
+}
@@ -0,0 +1,140 @@
+package printer
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+)
+
+func PrintEdgeStacks(stacks []types.EdgeStackDetails, format string) error {
+	switch format {
+	case "json":
+		return printJSON(stacks)
+	case "yaml":
+		return printYAML(stacks)
+	default:
+		return printEdgeStacksTable(stacks)
+	}
+}
+
+func PrintEdgeStack(stack types.EdgeStackDetails, format string) error {
+	switch format {
+	case "json":
+		return printJSON(stack)
+	case "yaml":
+		return printYAML(stack)
+	default:
+		return printEdgeStackDetails(stack)
+	}
+}
+
+func PrintEdgeStackStatus(statuses []types.EdgeStackEndpointStatus, format string) error {
+	switch format {
+	case "json":
+		return printJSON(statuses)
+	case "yaml":
+		return printYAML(statuses)
+	default:
+		return printEdgeStackStatusTable(statuses)
+	}
+}
+
+func printEdgeStacksTable(stacks []types.EdgeStackDetails) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(w, "ID\tNAME\tTYPE\tEDGE GROUPS\tVERSION\tSTATUS\tCREATED")
+	fmt.Fprintln(w, "--\t----\t----\t-----------\t-------\t------\t-------")
+
+	for _, stack := range stacks {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%d\t%s\t%s\n",
+			stack.ID,
+			stack.Name,
+			stack.DeploymentType,
+			valueOrDash(strings.Join(stack.EdgeGroupNames, ",")),
+			stack.Version,
+			valueOrDash(summarizeEdgeStatuses(reportedEdgeStatuses(stack.EdgeStack))),
+			formatUnixTime(stack.CreationDate),
+		)
+	}
+
+	return w.Flush()
+}
+
+func printEdgeStackDetails(stack types.EdgeStackDetails) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintf(w, "ID:\t%d\n", stack.ID)
+	fmt.Fprintf(w, "Name:\t%s\n", stack.Name)
+	fmt.Fprintf(w, "Type:\t%s\n", stack.DeploymentType)
+	fmt.Fprintf(w, "Edge Groups:\t%s\n", valueOrDash(strings.Join(stack.EdgeGroupNames, ", ")))
+	fmt.Fprintf(w, "Version:\t%d\n", stack.Version)
+	fmt.Fprintf(w, "Created:\t%s\n", formatUnixTime(stack.CreationDate))
+	if stack.FromGit() {
+		fmt.Fprintf(w, "Repository:\t%s\n", stack.GitConfig.URL)
+		fmt.Fprintf(w, "Reference:\t%s\n", valueOrDash(stack.GitConfig.ReferenceName))
+		fmt.Fprintf(w, "Stack File:\t%s\n", valueOrDash(stack.GitConfig.ConfigFilePath))
+	} else {
+		fmt.Fprintf(w, "Stack File:\t%s\n", valueOrDash(stack.EntryPoint))
+	}
+	fmt.Fprintf(w, "Status:\t%s\n", valueOrDash(summarizeEdgeStatuses(reportedEdgeStatuses(stack.EdgeStack))))
+
+	return w.Flush()
+}
+
+func printEdgeStackStatusTable(statuses []types.EdgeStackEndpointStatus) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(w, "ENDPOINT\tSTATUS\tUPDATED\tERROR")
+	fmt.Fprintln(w, "--------\t------\t-------\t-----")
+
+	values := make([]string, 0, len(statuses))
+	for _, status := range statuses {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
+			status.EndpointName,
+			status.Status,
+			formatUnixTime(status.Time),
+			valueOrDash(status.Error),
+		)
+		values = append(values, status.Status)
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Printf("\n%d environments: %s\n", len(statuses), summarizeEdgeStatuses(values))
+	return nil
+}
+
+func reportedEdgeStatuses(stack types.EdgeStack) []string {
+	var values []string
+	for _, status := range stack.Status {
+		if current, ok := status.Current(); ok {
+			values = append(values, current.Type.String())
+		}
+	}
+	return values
+}
+
+func summarizeEdgeStatuses(values []string) string {
+	counts := map[string]int{}
+	for _, value := range values {
+		counts[value]++
+	}
+
+	names := make([]string, 0, len(counts))
+	for name := range counts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		parts = append(parts, fmt.Sprintf("%d %s", counts[name], name))
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,78 @@
+package printer
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+)
+
+func PrintEndpoints(endpoints []types.EndpointDetails, format string) error {
+	switch format {
+	case "json":
+		return printJSON(endpoints)
+	case "yaml":
+		return printYAML(endpoints)
+	default:
+		return printEndpointsTable(endpoints)
+	}
+}
+
+func PrintEndpoint(endpoint types.EndpointDetails, format string) error {
+	switch format {
+	case "json":
+		return printJSON(endpoint)
+	case "yaml":
+		return printYAML(endpoint)
+	default:
+		return printEndpointDetails(endpoint)
+	}
+}
+
+func printEndpointsTable(endpoints []types.EndpointDetails) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(w, "ID\tNAME\tTYPE\tSTATUS\tURL\tGROUP\tTAGS\tSWARM ID")
+	fmt.Fprintln(w, "--\t----\t----\t------\t---\t-----\t----\t--------")
+
+	for _, endpoint := range endpoints {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			endpoint.ID,
+			endpoint.Name,
+			endpoint.Type.String(),
+			endpoint.Status.String(),
+			valueOrDash(endpoint.URL),
+			valueOrDash(endpoint.GroupName),
+			valueOrDash(strings.Join(endpoint.TagNames, ",")),
+			valueOrDash(truncate(endpoint.SwarmID, 12)),
+		)
+	}
+
+	return w.Flush()
+}
+
+func printEndpointDetails(endpoint types.EndpointDetails) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintf(w, "ID:\t%d\n", endpoint.ID)
+	fmt.Fprintf(w, "Name:\t%s\n", endpoint.Name)
+	fmt.Fprintf(w, "Type:\t%s\n", endpoint.Type.String())
+	fmt.Fprintf(w, "Status:\t%s\n", endpoint.Status.String())
+	fmt.Fprintf(w, "URL:\t%s\n", valueOrDash(endpoint.URL))
+	fmt.Fprintf(w, "Public URL:\t%s\n", valueOrDash(endpoint.PublicURL))
+	fmt.Fprintf(w, "Group:\t%s\n", valueOrDash(endpoint.GroupName))
+	fmt.Fprintf(w, "Tags:\t%s\n", valueOrDash(strings.Join(endpoint.TagNames, ", ")))
+	fmt.Fprintf(w, "Swarm ID:\t%s\n", valueOrDash(endpoint.SwarmID))
+
+	if len(endpoint.Snapshots) > 0 {
+		snapshot := endpoint.Snapshots[len(endpoint.Snapshots)-1]
+		fmt.Fprintf(w, "Docker Version:\t%s\n", valueOrDash(snapshot.DockerVersion))
+		fmt.Fprintf(w, "Containers:\t%d running, %d stopped\n", snapshot.RunningContainerCount, snapshot.StoppedContainerCount)
+		fmt.Fprintf(w, "Services:\t%d\n", snapshot.ServiceCount)
+		fmt.Fprintf(w, "Stacks:\t%d\n", snapshot.StackCount)
+	}
+
+	return w.Flush()
+}
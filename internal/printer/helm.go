@@ -0,0 +1,124 @@
+package printer
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+)
+
+func PrintHelmRepositories(repositories []types.HelmRepository, format string) error {
+	switch format {
+	case "json":
+		return printJSON(repositories)
+	case "yaml":
+		return printYAML(repositories)
+	default:
+		return printHelmRepositoriesTable(repositories)
+	}
+}
+
+func PrintHelmCharts(charts []types.HelmChartVersion, format string) error {
+	switch format {
+	case "json":
+		return printJSON(charts)
+	case "yaml":
+		return printYAML(charts)
+	default:
+		return printHelmChartsTable(charts)
+	}
+}
+
+func PrintHelmReleases(releases []types.HelmRelease, format string) error {
+	switch format {
+	case "json":
+		return printJSON(releases)
+	case "yaml":
+		return printYAML(releases)
+	default:
+		return printHelmReleasesTable(releases)
+	}
+}
+
+func PrintHelmReleaseDetails(release types.HelmReleaseDetails, format string) error {
+	switch format {
+	case "json":
+		return printJSON(release)
+	case "yaml":
+		return printYAML(release)
+	default:
+		return printHelmReleaseDetails(release)
+	}
+}
+
+func printHelmRepositoriesTable(repositories []types.HelmRepository) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(w, "ID\tURL\tSCOPE")
+	fmt.Fprintln(w, "--\t---\t-----")
+
+	for _, repository := range repositories {
+		id, scope := "-", "user"
+		if repository.Global {
+			scope = "global"
+		} else {
+			id = fmt.Sprintf("%d", repository.ID)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", id, repository.URL, scope)
+	}
+
+	return w.Flush()
+}
+
+func printHelmChartsTable(charts []types.HelmChartVersion) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(w, "NAME\tCHART VERSION\tAPP VERSION\tDESCRIPTION")
+	fmt.Fprintln(w, "----\t-------------\t-----------\t-----------")
+
+	for _, chart := range charts {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
+			chart.Name,
+			chart.Version,
+			valueOrDash(chart.AppVersion),
+			truncate(chart.Description, 60),
+		)
+	}
+
+	return w.Flush()
+}
+
+func printHelmReleasesTable(releases []types.HelmRelease) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(w, "NAME\tNAMESPACE\tREVISION\tSTATUS\tCHART\tAPP VERSION\tUPDATED")
+	fmt.Fprintln(w, "----\t---------\t--------\t------\t-----\t-----------\t-------")
+
+	for _, release := range releases {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			release.Name,
+			release.Namespace,
+			release.Revision,
+			release.Status,
+			release.Chart,
+			valueOrDash(release.AppVersion),
+			valueOrDash(release.Updated),
+		)
+	}
+
+	return w.Flush()
+}
+
+func printHelmReleaseDetails(release types.HelmReleaseDetails) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintf(w, "Release:\t%s\n", release.Name)
+	fmt.Fprintf(w, "Namespace:\t%s\n", release.Namespace)
+	fmt.Fprintf(w, "Chart:\t%s-%s\n", release.Chart.Metadata.Name, release.Chart.Metadata.Version)
+	fmt.Fprintf(w, "App Version:\t%s\n", valueOrDash(release.Chart.Metadata.AppVersion))
+	fmt.Fprintf(w, "Revision:\t%d\n", release.Version)
+	fmt.Fprintf(w, "Status:\t%s\n", valueOrDash(release.Info.Status))
+
+	return w.Flush()
+}
@@ -0,0 +1,70 @@
+package printer
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+)
+
+func PrintImages(images []types.ImageDetails, format string) error {
+	switch format {
+	case "json":
+		return printJSON(images)
+	case "yaml":
+		return printYAML(images)
+	default:
+		return printImagesTable(images)
+	}
+}
+
+func printImagesTable(images []types.ImageDetails) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(w, "IMAGE ID\tREPOSITORY:TAG\tSIZE\tCREATED\tNODE\tIN USE BY")
+	fmt.Fprintln(w, "--------\t--------------\t----\t-------\t----\t---------")
+
+	for _, image := range images {
+		tag := "<none>"
+		if !image.IsDangling() {
+			tag = image.RepoTags[0]
+			if len(image.RepoTags) > 1 {
+				tag += fmt.Sprintf(" (+%d)", len(image.RepoTags)-1)
+			}
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+			shortID(strings.TrimPrefix(image.ID, "sha256:")),
+			tag,
+			FormatSize(image.Size),
+			time.Unix(image.Created, 0).Format("2006-01-02 15:04"),
+			valueOrDash(image.Node),
+			valueOrDash(strings.Join(image.UsedBy, ",")),
+		)
+	}
+
+	return w.Flush()
+}
+
+func FormatSize(size int64) string {
+	const unit = 1000
+	if size < unit {
+		return fmt.Sprintf("%dB", size)
+	}
+
+	value := float64(size)
+	suffixes := []string{"kB", "MB", "GB", "TB"}
+	suffix := ""
+	for _, s := range suffixes {
+		value /= unit
+		suffix = s
+		if value < unit {
+			break
+		}
+	}
+
+	return fmt.Sprintf("%.1f%s", value, suffix)
+}
@@ -0,0 +1,23 @@
+package printer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatSize(t *testing.T) {
+	tests := []struct {
+		size     int64
+		expected string
+	}{
+		{512, "512B"},
+		{1500, "1.5kB"},
+		{187_000_000, "187.0MB"},
+		{2_500_000_000, "2.5GB"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, FormatSize(test.size))
+	}
+}
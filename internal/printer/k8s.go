@@ -0,0 +1,70 @@
+package printer
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+)
+
+func PrintKubernetesNamespaces(namespaces []types.KubernetesNamespace, format string) error {
+	switch format {
+	case "json":
+		return printJSON(namespaces)
+	case "yaml":
+		return printYAML(namespaces)
+	default:
+		return printKubernetesNamespacesTable(namespaces)
+	}
+}
+
+func PrintKubernetesApplications(apps []types.KubernetesApplication, format string) error {
+	switch format {
+	case "json":
+		return printJSON(apps)
+	case "yaml":
+		return printYAML(apps)
+	default:
+		return printKubernetesApplicationsTable(apps)
+	}
+}
+
+func printKubernetesNamespacesTable(namespaces []types.KubernetesNamespace) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(w, "NAME\tSTATUS\tSYSTEM\tCREATED")
+	fmt.Fprintln(w, "----\t------\t------\t-------")
+
+	for _, namespace := range namespaces {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
+			namespace.Metadata.Name,
+			valueOrDash(namespace.Status.Phase),
+			yesNo(namespace.IsSystem()),
+			valueOrDash(namespace.Metadata.CreationTimestamp),
+		)
+	}
+
+	return w.Flush()
+}
+
+func printKubernetesApplicationsTable(apps []types.KubernetesApplication) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(w, "NAMESPACE\tNAME\tKIND\tREADY\tIMAGE\tSTACK")
+	fmt.Fprintln(w, "---------\t----\t----\t-----\t-----\t-----")
+
+	for _, app := range apps {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d/%d\t%s\t%s\n",
+			app.Namespace,
+			app.Name,
+			app.Kind,
+			app.Ready,
+			app.Desired,
+			truncate(app.Image, 50),
+			valueOrDash(app.Stack),
+		)
+	}
+
+	return w.Flush()
+}
@@ -0,0 +1,93 @@
+package printer
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+)
+
+func PrintNetworks(networks []types.NetworkDetails, format string) error {
+	switch format {
+	case "json":
+		return printJSON(networks)
+	case "yaml":
+		return printYAML(networks)
+	default:
+		return printNetworksTable(networks)
+	}
+}
+
+func PrintNetwork(network types.Network, format string) error {
+	switch format {
+	case "json":
+		return printJSON(network)
+	case "yaml":
+		return printYAML(network)
+	default:
+		return printNetworkDetails(network)
+	}
+}
+
+func printNetworksTable(networks []types.NetworkDetails) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(w, "NETWORK ID\tNAME\tDRIVER\tSCOPE\tSTACK\tIN USE")
+	fmt.Fprintln(w, "----------\t----\t------\t-----\t-----\t------")
+
+	for _, network := range networks {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+			shortID(network.ID),
+			truncate(network.Name, 40),
+			network.Driver,
+			network.Scope,
+			valueOrDash(network.StackName()),
+			yesNo(!network.Unused),
+		)
+	}
+
+	return w.Flush()
+}
+
+func printNetworkDetails(network types.Network) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	subnets := make([]string, 0, len(network.IPAM.Config))
+	for _, config := range network.IPAM.Config {
+		if config.Subnet != "" {
+			subnets = append(subnets, config.Subnet)
+		}
+	}
+
+	fmt.Fprintf(w, "ID:\t%s\n", network.ID)
+	fmt.Fprintf(w, "Name:\t%s\n", network.Name)
+	fmt.Fprintf(w, "Driver:\t%s\n", network.Driver)
+	fmt.Fprintf(w, "Scope:\t%s\n", network.Scope)
+	fmt.Fprintf(w, "Created:\t%s\n", network.Created)
+	fmt.Fprintf(w, "Subnets:\t%s\n", valueOrDash(strings.Join(subnets, ", ")))
+	fmt.Fprintf(w, "Internal:\t%t\n", network.Internal)
+	fmt.Fprintf(w, "Attachable:\t%t\n", network.Attachable)
+	fmt.Fprintf(w, "Stack:\t%s\n", valueOrDash(network.StackName()))
+
+	if len(network.Containers) > 0 {
+		names := make([]string, 0, len(network.Containers))
+		addresses := map[string]string{}
+		for _, container := range network.Containers {
+			names = append(names, container.Name)
+			addresses[container.Name] = container.IPv4Address
+		}
+		sort.Strings(names)
+
+		fmt.Fprintln(w, "Containers:\t")
+		for _, name := range names {
+			fmt.Fprintf(w, "  %s\t%s\n", name, valueOrDash(addresses[name]))
+		}
+	}
+
+	printLabels(w, network.Labels)
+
+	return w.Flush()
+}
@@ -0,0 +1,78 @@
+package printer
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+)
+
+func PrintNodes(nodes []types.NodeDetails, format string) error {
+	switch format {
+	case "json":
+		return printJSON(nodes)
+	case "yaml":
+		return printYAML(nodes)
+	default:
+		return printNodesTable(nodes)
+	}
+}
+
+func PrintNode(node types.NodeDetails, format string) error {
+	switch format {
+	case "json":
+		return printJSON(node)
+	case "yaml":
+		return printYAML(node)
+	default:
+		return printNodeDetails(node)
+	}
+}
+
+func printNodesTable(nodes []types.NodeDetails) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(w, "ID\tHOSTNAME\tSTATUS\tAVAILABILITY\tROLE\tMANAGER STATUS\tENGINE\tTASKS")
+	fmt.Fprintln(w, "--\t--------\t------\t------------\t----\t--------------\t------\t-----")
+
+	for _, node := range nodes {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%d\n",
+			shortID(node.ID),
+			node.Description.Hostname,
+			node.Status.State,
+			node.Spec.Availability,
+			node.Spec.Role,
+			valueOrDash(node.ManagerRole()),
+			valueOrDash(node.Description.Engine.EngineVersion),
+			node.RunningTasks,
+		)
+	}
+
+	return w.Flush()
+}
+
+func printNodeDetails(node types.NodeDetails) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintf(w, "ID:\t%s\n", node.ID)
+	fmt.Fprintf(w, "Hostname:\t%s\n", node.Description.Hostname)
+	fmt.Fprintf(w, "Address:\t%s\n", node.Status.Addr)
+	fmt.Fprintf(w, "Status:\t%s\n", node.Status.State)
+	if node.Status.Message != "" {
+		fmt.Fprintf(w, "Status Message:\t%s\n", node.Status.Message)
+	}
+	fmt.Fprintf(w, "Availability:\t%s\n", node.Spec.Availability)
+	fmt.Fprintf(w, "Role:\t%s\n", node.Spec.Role)
+	if node.ManagerStatus != nil {
+		fmt.Fprintf(w, "Manager Status:\t%s\n", node.ManagerRole())
+	}
+	fmt.Fprintf(w, "Platform:\t%s/%s\n", node.Description.Platform.OS, node.Description.Platform.Architecture)
+	fmt.Fprintf(w, "CPUs:\t%d\n", node.Description.Resources.NanoCPUs/1e9)
+	fmt.Fprintf(w, "Memory:\t%s\n", FormatSize(node.Description.Resources.MemoryBytes))
+	fmt.Fprintf(w, "Engine:\t%s\n", valueOrDash(node.Description.Engine.EngineVersion))
+	fmt.Fprintf(w, "Running Tasks:\t%d\n", node.RunningTasks)
+	printLabels(w, node.Spec.Labels)
+
+	return w.Flush()
+}
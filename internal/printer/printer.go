@@ -0,0 +1,68 @@
+package printer
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+func printJSON(v interface{}) error {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+func printYAML(v interface{}) error {
+	return yaml.NewEncoder(os.Stdout).Encode(v)
+}
+
+func valueOrDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
+
+func truncate(value string, max int) string {
+	if len(value) > max {
+		return value[:max] + "..."
+	}
+	return value
+}
+
+func formatDockerTime(value string) string {
+	parsed, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return valueOrDash(value)
+	}
+	return parsed.Local().Format("2006-01-02 15:04")
+}
+
+func shortID(id string) string {
+	if len(id) > 12 {
+		return id[:12]
+	}
+	return id
+}
+
+func printLabels(w io.Writer, labels map[string]string) {
+	if len(labels) == 0 {
+		return
+	}
+
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	fmt.Fprintln(w, "Labels:\t")
+	for _, key := range keys {
+		fmt.Fprintf(w, "  %s\t%s\n", key, labels[key])
+	}
+}
@@ -0,0 +1,101 @@
+package printer
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+)
+
+func PrintRegistries(registries []types.Registry, format string) error {
+	switch format {
+	case "json":
+		return printJSON(registries)
+	case "yaml":
+		return printYAML(registries)
+	default:
+		return printRegistriesTable(registries)
+	}
+}
+
+func PrintRegistry(registry types.Registry, format string) error {
+	switch format {
+	case "json":
+		return printJSON(registry)
+	case "yaml":
+		return printYAML(registry)
+	default:
+		return printRegistryDetails(registry)
+	}
+}
+
+func printRegistriesTable(registries []types.Registry) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(w, "ID\tNAME\tTYPE\tURL\tAUTHENTICATION\tUSERNAME")
+	fmt.Fprintln(w, "--\t----\t----\t---\t--------------\t--------")
+
+	for _, registry := range registries {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\n",
+			registry.ID,
+			registry.Name,
+			registry.Type,
+			truncate(registry.URL, 50),
+			yesNo(registry.Authentication),
+			valueOrDash(registry.Username),
+		)
+	}
+
+	return w.Flush()
+}
+
+func printRegistryDetails(registry types.Registry) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintf(w, "ID:\t%d\n", registry.ID)
+	fmt.Fprintf(w, "Name:\t%s\n", registry.Name)
+	fmt.Fprintf(w, "Type:\t%s\n", registry.Type)
+	fmt.Fprintf(w, "URL:\t%s\n", registry.URL)
+	fmt.Fprintf(w, "Authentication:\t%s\n", yesNo(registry.Authentication))
+	fmt.Fprintf(w, "Username:\t%s\n", valueOrDash(registry.Username))
+	if registry.Ecr != nil {
+		fmt.Fprintf(w, "ECR Region:\t%s\n", registry.Ecr.Region)
+	}
+
+	if len(registry.RegistryAccesses) > 0 {
+		endpointIDs := make([]int, 0, len(registry.RegistryAccesses))
+		for endpointID := range registry.RegistryAccesses {
+			endpointIDs = append(endpointIDs, endpointID)
+		}
+		sort.Ints(endpointIDs)
+
+		fmt.Fprintln(w, "Access:\t")
+		for _, endpointID := range endpointIDs {
+			access := registry.RegistryAccesses[endpointID]
+			fmt.Fprintf(w, "  endpoint %d\tusers: %s, teams: %s\n",
+				endpointID,
+				valueOrDash(joinPolicyIDs(access.UserAccessPolicies)),
+				valueOrDash(joinPolicyIDs(access.TeamAccessPolicies)),
+			)
+		}
+	}
+
+	return w.Flush()
+}
+
+func joinPolicyIDs(policies map[int]types.AccessPolicy) string {
+	ids := make([]int, 0, len(policies))
+	for id := range policies {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	values := make([]string, 0, len(ids))
+	for _, id := range ids {
+		values = append(values, fmt.Sprintf("%d", id))
+	}
+	return strings.Join(values, ",")
+}
@@ -0,0 +1,127 @@
+package printer
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+)
+
+func PrintSecrets(secrets []types.Secret, format string) error {
+	switch format {
+	case "json":
+		return printJSON(secrets)
+	case "yaml":
+		return printYAML(secrets)
+	default:
+		return printSecretsTable(secrets)
+	}
+}
+
+func PrintSecret(secret types.SecretDetails, format string) error {
+	switch format {
+	case "json":
+		return printJSON(secret)
+	case "yaml":
+		return printYAML(secret)
+	default:
+		return printSecretDetails(secret)
+	}
+}
+
+func PrintConfigs(configs []types.SwarmConfig, format string) error {
+	switch format {
+	case "json":
+		return printJSON(configs)
+	case "yaml":
+		return printYAML(configs)
+	default:
+		return printConfigsTable(configs)
+	}
+}
+
+func PrintConfig(config types.SwarmConfigDetails, format string) error {
+	switch format {
+	case "json":
+		return printJSON(config)
+	case "yaml":
+		return printYAML(config)
+	default:
+		return printConfigDetails(config)
+	}
+}
+
+func printSecretsTable(secrets []types.Secret) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(w, "ID\tNAME\tSTACK\tCREATED\tUPDATED")
+	fmt.Fprintln(w, "--\t----\t-----\t-------\t-------")
+
+	for _, secret := range secrets {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+			shortID(secret.ID),
+			secret.Spec.Name,
+			valueOrDash(secret.StackName()),
+			formatDockerTime(secret.CreatedAt),
+			formatDockerTime(secret.UpdatedAt),
+		)
+	}
+
+	return w.Flush()
+}
+
+func printSecretDetails(secret types.SecretDetails) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintf(w, "ID:\t%s\n", secret.ID)
+	fmt.Fprintf(w, "Name:\t%s\n", secret.Spec.Name)
+	fmt.Fprintf(w, "Stack:\t%s\n", valueOrDash(secret.StackName()))
+	fmt.Fprintf(w, "Created At:\t%s\n", secret.CreatedAt)
+	fmt.Fprintf(w, "Updated At:\t%s\n", secret.UpdatedAt)
+	fmt.Fprintf(w, "Used By:\t%s\n", valueOrDash(strings.Join(secret.UsedBy, ", ")))
+	printLabels(w, secret.Spec.Labels)
+
+	return w.Flush()
+}
+
+func printConfigsTable(configs []types.SwarmConfig) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(w, "ID\tNAME\tSTACK\tCREATED\tUPDATED")
+	fmt.Fprintln(w, "--\t----\t-----\t-------\t-------")
+
+	for _, config := range configs {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+			shortID(config.ID),
+			config.Spec.Name,
+			valueOrDash(config.StackName()),
+			formatDockerTime(config.CreatedAt),
+			formatDockerTime(config.UpdatedAt),
+		)
+	}
+
+	return w.Flush()
+}
+
+func printConfigDetails(config types.SwarmConfigDetails) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintf(w, "ID:\t%s\n", config.ID)
+	fmt.Fprintf(w, "Name:\t%s\n", config.Spec.Name)
+	fmt.Fprintf(w, "Stack:\t%s\n", valueOrDash(config.StackName()))
+	fmt.Fprintf(w, "Created At:\t%s\n", config.CreatedAt)
+	fmt.Fprintf(w, "Updated At:\t%s\n", config.UpdatedAt)
+	fmt.Fprintf(w, "Used By:\t%s\n", valueOrDash(strings.Join(config.UsedBy, ", ")))
+	printLabels(w, config.Spec.Labels)
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Println("Data:")
+	fmt.Println(strings.TrimRight(string(config.Spec.Data), "\n"))
+
+	return nil
+}
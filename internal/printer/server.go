@@ -0,0 +1,46 @@
+package printer
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+)
+
+func PrintServerStatus(status types.ServerStatus, format string) error {
+	switch format {
+	case "json":
+		return printJSON(status)
+	case "yaml":
+		return printYAML(status)
+	default:
+		return printServerStatusDetails(status)
+	}
+}
+
+func printServerStatusDetails(status types.ServerStatus) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintf(w, "Server:\t%s\n", status.URL)
+	fmt.Fprintf(w, "Version:\t%s\n", status.Version)
+	fmt.Fprintf(w, "Edition:\t%s\n", valueOrDash(status.Edition))
+	fmt.Fprintf(w, "Instance ID:\t%s\n", valueOrDash(status.InstanceID))
+	if status.DatabaseVersion != "" {
+		fmt.Fprintf(w, "Database Version:\t%s\n", status.DatabaseVersion)
+	}
+	if status.Platform != "" {
+		fmt.Fprintf(w, "Platform:\t%s\n", status.Platform)
+		fmt.Fprintf(w, "Agents:\t%d (edge: %d)\n", status.Agents, status.EdgeAgents)
+	}
+	if status.UpdateAvailable {
+		fmt.Fprintf(w, "Update Available:\t%s\n", valueOrDash(status.LatestVersion))
+	}
+	fmt.Fprintf(w, "Response Time:\t%dms\n", status.ResponseTimeMS)
+	fmt.Fprintf(w, "CLI Compatibility:\t%s (%s)\n", status.Compatibility, status.SupportedRange)
+	if status.Note != "" {
+		fmt.Fprintf(w, "Note:\t%s\n", status.Note)
+	}
+
+	return w.Flush()
+}
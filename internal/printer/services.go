@@ -0,0 +1,84 @@
+package printer
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+)
+
+func PrintServices(services []types.Service, format string) error {
+	switch format {
+	case "json":
+		return printJSON(services)
+	case "yaml":
+		return printYAML(services)
+	default:
+		return printServicesTable(services)
+	}
+}
+
+func PrintService(service types.Service, format string) error {
+	switch format {
+	case "json":
+		return printJSON(service)
+	case "yaml":
+		return printYAML(service)
+	default:
+		return printServiceDetails(service)
+	}
+}
+
+func printServicesTable(services []types.Service) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(w, "ID\tNAME\tMODE\tREPLICAS\tIMAGE\tSTACK")
+	fmt.Fprintln(w, "--\t----\t----\t--------\t-----\t-----")
+
+	for _, service := range services {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+			shortID(service.ID),
+			service.Spec.Name,
+			service.Mode(),
+			serviceReplicas(service),
+			truncate(service.Image(), 40),
+			valueOrDash(service.StackName()),
+		)
+	}
+
+	return w.Flush()
+}
+
+func printServiceDetails(service types.Service) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintf(w, "ID:\t%s\n", service.ID)
+	fmt.Fprintf(w, "Name:\t%s\n", service.Spec.Name)
+	fmt.Fprintf(w, "Mode:\t%s\n", service.Mode())
+	fmt.Fprintf(w, "Replicas:\t%s\n", serviceReplicas(service))
+	fmt.Fprintf(w, "Image:\t%s\n", service.Spec.TaskTemplate.ContainerSpec.Image)
+	fmt.Fprintf(w, "Stack:\t%s\n", valueOrDash(service.StackName()))
+	fmt.Fprintf(w, "Version:\t%d\n", service.Version.Index)
+	fmt.Fprintf(w, "Created At:\t%s\n", service.CreatedAt)
+	fmt.Fprintf(w, "Updated At:\t%s\n", service.UpdatedAt)
+	if service.UpdateStatus != nil {
+		fmt.Fprintf(w, "Update Status:\t%s\n", service.UpdateStatus.State)
+		if service.UpdateStatus.Message != "" {
+			fmt.Fprintf(w, "Update Message:\t%s\n", service.UpdateStatus.Message)
+		}
+	}
+	printLabels(w, service.Spec.Labels)
+
+	return w.Flush()
+}
+
+func serviceReplicas(service types.Service) string {
+	if service.ServiceStatus != nil {
+		return fmt.Sprintf("%d/%d", service.ServiceStatus.RunningTasks, service.ServiceStatus.DesiredTasks)
+	}
+	if replicated := service.Spec.Mode.Replicated; replicated != nil && replicated.Replicas != nil {
+		return fmt.Sprintf("%d", *replicated.Replicas)
+	}
+	return "-"
+}
@@ -0,0 +1,45 @@
+package printer
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+)
+
+func PrintSettings(document interface{}, values []types.SettingValue, format string) error {
+	switch format {
+	case "json":
+		return printJSON(document)
+	case "yaml":
+		return printYAML(document)
+	default:
+		return printSettingsTable(values)
+	}
+}
+
+func printSettingsTable(values []types.SettingValue) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(w, "KEY\tVALUE")
+	fmt.Fprintln(w, "---\t-----")
+
+	for _, setting := range values {
+		fmt.Fprintf(w, "%s\t%s\n", setting.Key, truncate(settingString(setting.Value), 80))
+	}
+
+	return w.Flush()
+}
+
+func settingString(value interface{}) string {
+	if s, ok := value.(string); ok {
+		return valueOrDash(s)
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(data)
+}
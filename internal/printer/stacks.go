@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 	"text/tabwriter"
 
 	"gopkg.in/yaml.v3"
@@ -25,8 +26,8 @@ func PrintStacks(stacks []types.Stack, format string) error {
 func printStacksTable(stacks []types.Stack) error {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
 
-	fmt.Fprintln(w, "ID\tNAME\tTYPE\tSTATUS\tENDPOINT\tSWARM ID")
-	fmt.Fprintln(w, "--\t----\t----\t------\t--------\t--------")
+	fmt.Fprintln(w, "ID\tNAME\tTYPE\tSTATUS\tENDPOINT\tSWARM ID\tNAMESPACE")
+	fmt.Fprintln(w, "--\t----\t----\t------\t--------\t--------\t---------")
 
 	for _, stack := range stacks {
 		swarmID := stack.SwarmID
@@ -37,13 +38,14 @@ func printStacksTable(stacks []types.Stack) error {
 			swarmID = "-"
 		}
 
-		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%d\t%s\n",
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%d\t%s\t%s\n",
 			stack.ID,
 			stack.Name,
 			stack.Type.String(),
 			stack.StatusString(),
 			stack.EndpointID,
 			swarmID,
+			valueOrDash(stack.Namespace),
 		)
 	}
 
@@ -59,3 +61,28 @@ func printStacksJSON(stacks []types.Stack) error {
 func printStacksYAML(stacks []types.Stack) error {
 	return yaml.NewEncoder(os.Stdout).Encode(stacks)
 }
+
+func PrintStackAccess(access types.StackAccess, format string) error {
+	switch format {
+	case "json":
+		return printJSON(access)
+	case "yaml":
+		return printYAML(access)
+	default:
+		return printStackAccessDetails(access)
+	}
+}
+
+func printStackAccessDetails(access types.StackAccess) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintf(w, "Stack:\t%s (ID: %d)\n", access.StackName, access.StackID)
+	fmt.Fprintf(w, "Ownership:\t%s\n", access.Ownership)
+	if access.ResourceControlID > 0 {
+		fmt.Fprintf(w, "Resource Control:\t%d\n", access.ResourceControlID)
+	}
+	fmt.Fprintf(w, "Users:\t%s\n", valueOrDash(strings.Join(access.Users, ", ")))
+	fmt.Fprintf(w, "Teams:\t%s\n", valueOrDash(strings.Join(access.Teams, ", ")))
+
+	return w.Flush()
+}
@@ -0,0 +1,79 @@
+package printer
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+)
+
+func PrintTags(tags []types.Tag, format string) error {
+	switch format {
+	case "json":
+		return printJSON(tags)
+	case "yaml":
+		return printYAML(tags)
+	default:
+		return printTagsTable(tags)
+	}
+}
+
+func PrintEndpointGroups(groups []types.EndpointGroupDetails, format string) error {
+	switch format {
+	case "json":
+		return printJSON(groups)
+	case "yaml":
+		return printYAML(groups)
+	default:
+		return printEndpointGroupsTable(groups)
+	}
+}
+
+func printTagsTable(tags []types.Tag) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(w, "ID\tNAME\tENDPOINTS\tGROUPS")
+	fmt.Fprintln(w, "--\t----\t---------\t------")
+
+	for _, tag := range tags {
+		fmt.Fprintf(w, "%d\t%s\t%d\t%d\n",
+			tag.ID,
+			tag.Name,
+			countTrue(tag.Endpoints),
+			countTrue(tag.EndpointGroups),
+		)
+	}
+
+	return w.Flush()
+}
+
+func printEndpointGroupsTable(groups []types.EndpointGroupDetails) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(w, "ID\tNAME\tDESCRIPTION\tTAGS\tENDPOINTS")
+	fmt.Fprintln(w, "--\t----\t-----------\t----\t---------")
+
+	for _, group := range groups {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\n",
+			group.ID,
+			group.Name,
+			valueOrDash(truncate(group.Description, 40)),
+			valueOrDash(strings.Join(group.TagNames, ",")),
+			valueOrDash(strings.Join(group.Endpoints, ",")),
+		)
+	}
+
+	return w.Flush()
+}
+
+func countTrue(values map[int]bool) int {
+	count := 0
+	for _, value := range values {
+		if value {
+			count++
+		}
+	}
+	return count
+}
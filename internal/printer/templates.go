@@ -0,0 +1,118 @@
+package printer
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+)
+
+func PrintCustomTemplates(templates []types.CustomTemplate, format string) error {
+	switch format {
+	case "json":
+		return printJSON(templates)
+	case "yaml":
+		return printYAML(templates)
+	default:
+		return printCustomTemplatesTable(templates)
+	}
+}
+
+func PrintCustomTemplate(template types.CustomTemplate, format string) error {
+	switch format {
+	case "json":
+		return printJSON(template)
+	case "yaml":
+		return printYAML(template)
+	default:
+		return printCustomTemplateDetails(template)
+	}
+}
+
+func printCustomTemplatesTable(templates []types.CustomTemplate) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(w, "ID\tTITLE\tTYPE\tPLATFORM\tVARIABLES\tDESCRIPTION")
+	fmt.Fprintln(w, "--\t-----\t----\t--------\t---------\t-----------")
+
+	for _, template := range templates {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%d\t%s\n",
+			template.ID,
+			template.Title,
+			template.Type,
+			template.Platform,
+			len(template.Variables),
+			truncate(template.Description, 50),
+		)
+	}
+
+	return w.Flush()
+}
+
+func printCustomTemplateDetails(template types.CustomTemplate) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintf(w, "ID:\t%d\n", template.ID)
+	fmt.Fprintf(w, "Title:\t%s\n", template.Title)
+	fmt.Fprintf(w, "Description:\t%s\n", valueOrDash(template.Description))
+	fmt.Fprintf(w, "Note:\t%s\n", valueOrDash(template.Note))
+	fmt.Fprintf(w, "Type:\t%s\n", template.Type)
+	fmt.Fprintf(w, "Platform:\t%s\n", template.Platform)
+	if template.GitConfig != nil {
+		fmt.Fprintf(w, "Repository:\t%s\n", template.GitConfig.URL)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if len(template.Variables) == 0 {
+		return nil
+	}
+
+	fmt.Println()
+	w = tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "VARIABLE\tLABEL\tDEFAULT\tDESCRIPTION")
+	fmt.Fprintln(w, "--------\t-----\t-------\t-----------")
+	for _, variable := range template.Variables {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
+			variable.Name,
+			valueOrDash(variable.Label),
+			valueOrDash(variable.DefaultValue),
+			valueOrDash(variable.Description),
+		)
+	}
+
+	return w.Flush()
+}
+
+func PrintAppTemplates(templates []types.AppTemplate, format string) error {
+	switch format {
+	case "json":
+		return printJSON(templates)
+	case "yaml":
+		return printYAML(templates)
+	default:
+		return printAppTemplatesTable(templates)
+	}
+}
+
+func printAppTemplatesTable(templates []types.AppTemplate) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(w, "ID\tTITLE\tTYPE\tCATEGORIES\tDESCRIPTION")
+	fmt.Fprintln(w, "--\t-----\t----\t----------\t-----------")
+
+	for _, template := range templates {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\n",
+			template.ID,
+			template.Title,
+			template.Type,
+			valueOrDash(strings.Join(template.Categories, ", ")),
+			truncate(template.Description, 50),
+		)
+	}
+
+	return w.Flush()
+}
@@ -0,0 +1,47 @@
+package printer
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+)
+
+func PrintAPITokens(tokens []types.APIToken, format string) error {
+	switch format {
+	case "json":
+		return printJSON(tokens)
+	case "yaml":
+		return printYAML(tokens)
+	default:
+		return printAPITokensTable(tokens)
+	}
+}
+
+func printAPITokensTable(tokens []types.APIToken) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(w, "ID\tDESCRIPTION\tPREFIX\tCREATED\tLAST USED")
+	fmt.Fprintln(w, "--\t-----------\t------\t-------\t---------")
+
+	for _, token := range tokens {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\n",
+			token.ID,
+			valueOrDash(token.Description),
+			token.Prefix,
+			formatUnixTime(token.DateCreated),
+			formatUnixTime(token.LastUsed),
+		)
+	}
+
+	return w.Flush()
+}
+
+func formatUnixTime(seconds int64) string {
+	if seconds <= 0 {
+		return "-"
+	}
+	return time.Unix(seconds, 0).Format("2006-01-02 15:04")
+}
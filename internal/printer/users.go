@@ -0,0 +1,68 @@
+package printer
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+)
+
+func PrintUsers(users []types.UserDetails, format string) error {
+	switch format {
+	case "json":
+		return printJSON(users)
+	case "yaml":
+		return printYAML(users)
+	default:
+		return printUsersTable(users)
+	}
+}
+
+func PrintTeams(teams []types.TeamDetails, format string) error {
+	switch format {
+	case "json":
+		return printJSON(teams)
+	case "yaml":
+		return printYAML(teams)
+	default:
+		return printTeamsTable(teams)
+	}
+}
+
+func printUsersTable(users []types.UserDetails) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(w, "ID\tUSERNAME\tROLE\tTEAMS")
+	fmt.Fprintln(w, "--\t--------\t----\t-----")
+
+	for _, user := range users {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\n",
+			user.ID,
+			user.Username,
+			user.Role,
+			valueOrDash(strings.Join(user.Teams, ",")),
+		)
+	}
+
+	return w.Flush()
+}
+
+func printTeamsTable(teams []types.TeamDetails) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(w, "ID\tNAME\tLEADERS\tMEMBERS")
+	fmt.Fprintln(w, "--\t----\t-------\t-------")
+
+	for _, team := range teams {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\n",
+			team.ID,
+			team.Name,
+			valueOrDash(strings.Join(team.Leaders, ",")),
+			valueOrDash(strings.Join(team.Members, ",")),
+		)
+	}
+
+	return w.Flush()
+}
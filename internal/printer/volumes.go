@@ -0,0 +1,88 @@
+package printer
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+)
+
+func PrintVolumes(volumes []types.VolumeDetails, format string) error {
+	switch format {
+	case "json":
+		return printJSON(volumes)
+	case "yaml":
+		return printYAML(volumes)
+	default:
+		return printVolumesTable(volumes)
+	}
+}
+
+func PrintVolume(volume types.Volume, format string) error {
+	switch format {
+	case "json":
+		return printJSON(volume)
+	case "yaml":
+		return printYAML(volume)
+	default:
+		return printVolumeDetails(volume)
+	}
+}
+
+func printVolumesTable(volumes []types.VolumeDetails) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(w, "NAME\tDRIVER\tSCOPE\tSTACK\tNODE\tIN USE")
+	fmt.Fprintln(w, "----\t------\t-----\t-----\t----\t------")
+
+	for _, volume := range volumes {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+			truncate(volume.Name, 40),
+			volume.Driver,
+			volume.Scope,
+			valueOrDash(volume.StackName()),
+			valueOrDash(volume.NodeName()),
+			yesNo(!volume.Unused),
+		)
+	}
+
+	return w.Flush()
+}
+
+func printVolumeDetails(volume types.Volume) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintf(w, "Name:\t%s\n", volume.Name)
+	fmt.Fprintf(w, "Driver:\t%s\n", volume.Driver)
+	fmt.Fprintf(w, "Scope:\t%s\n", volume.Scope)
+	fmt.Fprintf(w, "Mountpoint:\t%s\n", volume.Mountpoint)
+	fmt.Fprintf(w, "Created:\t%s\n", valueOrDash(volume.CreatedAt))
+	fmt.Fprintf(w, "Node:\t%s\n", valueOrDash(volume.NodeName()))
+	fmt.Fprintf(w, "Stack:\t%s\n", valueOrDash(volume.StackName()))
+
+	if len(volume.Options) > 0 {
+		keys := make([]string, 0, len(volume.Options))
+		for key := range volume.Options {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		fmt.Fprintln(w, "Options:\t")
+		for _, key := range keys {
+			fmt.Fprintf(w, "  %s\t%s\n", key, volume.Options[key])
+		}
+	}
+
+	printLabels(w, volume.Labels)
+
+	return w.Flush()
+}
+
+func yesNo(value bool) string {
+	if value {
+		return "yes"
+	}
+	return "no"
+}
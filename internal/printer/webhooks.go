@@ -0,0 +1,43 @@
+package printer
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+)
+
+func PrintWebhooks(webhooks []types.WebhookDetails, format string) error {
+	switch format {
+	case "json":
+		return printJSON(webhooks)
+	case "yaml":
+		return printYAML(webhooks)
+	default:
+		return printWebhooksTable(webhooks)
+	}
+}
+
+func printWebhooksTable(webhooks []types.WebhookDetails) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(w, "TYPE\tID\tRESOURCE\tENDPOINT\tURL")
+	fmt.Fprintln(w, "----\t--\t--------\t--------\t---")
+
+	for _, webhook := range webhooks {
+		id := "-"
+		if webhook.ID > 0 {
+			id = fmt.Sprintf("%d", webhook.ID)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\n",
+			webhook.Type,
+			id,
+			truncate(webhook.Resource, 30),
+			webhook.EndpointID,
+			webhook.URL,
+		)
+	}
+
+	return w.Flush()
+}
@@ -77,13 +77,8 @@ func RunCreateSwarmGitWizard() (*types.StackCreateSwarmGitPayload, int, error) {
 
 			huh.NewInput().
 				Title("Swarm Cluster ID").
-				Value(&data.SwarmID).
-				Validate(func(s string) error {
-					if len(s) < 1 {
-						return errors.New("swarm ID cannot be empty")
-					}
-					return nil
-				}),
+				Description("Leave empty to detect it from the endpoint").
+				Value(&data.SwarmID),
 
 			huh.NewInput().
 				Title("Endpoint ID").
@@ -0,0 +1,41 @@
+package wizard
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/huh"
+	"github.com/pdrhp/portainer-go-cli/pkg/types"
+)
+
+func RunTemplateVariablesWizard(title string, variables []types.CustomTemplateVariable) (map[string]string, error) {
+	values := make([]string, len(variables))
+	fields := make([]huh.Field, 0, len(variables))
+	for i, variable := range variables {
+		values[i] = variable.DefaultValue
+
+		label := variable.Label
+		if label == "" {
+			label = variable.Name
+		}
+
+		fields = append(fields, huh.NewInput().
+			Title(label).
+			Description(variable.Description).
+			Value(&values[i]))
+	}
+
+	form := huh.NewForm(
+		huh.NewGroup(fields...).Title(fmt.Sprintf("Variables of template '%s'", title)),
+	).WithTheme(huh.ThemeCharm())
+
+	if err := form.Run(); err != nil {
+		return nil, fmt.Errorf("wizard cancelled: %w", err)
+	}
+
+	result := make(map[string]string, len(variables))
+	for i, variable := range variables {
+		result[variable.Name] = values[i]
+	}
+
+	return result, nil
+}
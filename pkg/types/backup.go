@@ -0,0 +1,42 @@
+package types
+
+type BackupRequest struct {
+	Password string `json:"password,omitempty"`
+}
+
+type BackupArchive struct {
+	Filename  string `json:"filename"`
+	Size      int64  `json:"size"`
+	SHA256    string `json:"sha256"`
+	Encrypted bool   `json:"encrypted"`
+}
+
+type BackupS3Settings struct {
+	AccessKeyID      string `json:"accessKeyID"`
+	SecretAccessKey  string `json:"secretAccessKey"`
+	Region           string `json:"region"`
+	BucketName       string `json:"bucketName"`
+	S3CompatibleHost string `json:"s3CompatibleHost"`
+	Password         string `json:"password"`
+	CronRule         string `json:"cronRule"`
+}
+
+type BackupS3Status struct {
+	Failed       bool   `json:"Failed"`
+	TimestampUTC string `json:"TimestampUTC"`
+}
+
+func (s BackupS3Settings) Masked() BackupS3Settings {
+	if s.SecretAccessKey != "" {
+		s.SecretAccessKey = "********"
+	}
+	if s.Password != "" {
+		s.Password = "********"
+	}
+	return s
+}
+
+type BackupS3Details struct {
+	Settings BackupS3Settings `json:"settings"`
+	Status   BackupS3Status   `json:"status"`
+}
@@ -0,0 +1,84 @@
+package types
+
+import "strings"
+
+type Container struct {
+	ID      string            `json:"Id"`
+	Names   []string          `json:"Names"`
+	Image   string            `json:"Image"`
+	ImageID string            `json:"ImageID"`
+	Command string            `json:"Command"`
+	Created int64             `json:"Created"`
+	State   string            `json:"State"`
+	Status  string            `json:"Status"`
+	Ports   []ContainerPort   `json:"Ports,omitempty"`
+	Labels  map[string]string `json:"Labels,omitempty"`
+}
+
+type ContainerPort struct {
+	IP          string `json:"IP,omitempty"`
+	PrivatePort int    `json:"PrivatePort"`
+	PublicPort  int    `json:"PublicPort,omitempty"`
+	Type        string `json:"Type"`
+}
+
+type ContainerDetails struct {
+	ID           string          `json:"Id"`
+	Name         string          `json:"Name"`
+	Created      string          `json:"Created"`
+	Image        string          `json:"Image"`
+	RestartCount int             `json:"RestartCount"`
+	State        ContainerState  `json:"State"`
+	Config       ContainerConfig `json:"Config"`
+}
+
+type ContainerState struct {
+	Status     string           `json:"Status"`
+	Running    bool             `json:"Running"`
+	Paused     bool             `json:"Paused"`
+	Restarting bool             `json:"Restarting"`
+	OOMKilled  bool             `json:"OOMKilled"`
+	Dead       bool             `json:"Dead"`
+	Pid        int              `json:"Pid"`
+	ExitCode   int              `json:"ExitCode"`
+	Error      string           `json:"Error,omitempty"`
+	StartedAt  string           `json:"StartedAt"`
+	FinishedAt string           `json:"FinishedAt"`
+	Health     *ContainerHealth `json:"Health,omitempty"`
+}
+
+type ContainerHealth struct {
+	Status        string `json:"Status"`
+	FailingStreak int    `json:"FailingStreak"`
+}
+
+type ContainerConfig struct {
+	Hostname string            `json:"Hostname"`
+	Image    string            `json:"Image"`
+	Env      []string          `json:"Env,omitempty"`
+	Cmd      []string          `json:"Cmd,omitempty"`
+	Labels   map[string]string `json:"Labels,omitempty"`
+}
+
+type ContainerFilters struct {
+	All    bool     `json:"all,omitempty"`
+	Stack  string   `json:"stack,omitempty"`
+	Labels []string `json:"labels,omitempty"`
+	Status []string `json:"status,omitempty"`
+}
+
+func (c Container) Name() string {
+	if len(c.Names) == 0 {
+		return ""
+	}
+	return strings.TrimPrefix(c.Names[0], "/")
+}
+
+func (c Container) StackName() string {
+	return StackFromLabels(c.Labels)
+}
+
+func (c Container) ExitedSuccessfully() bool {
+	// The list endpoint only reports the exit code in the status text, as in "Exited (0) 5 seconds ago"
+	return c.State == "exited" && strings.HasPrefix(c.Status, "Exited (0)")
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContainer_Name(t *testing.T) {
+	assert.Equal(t, "web", Container{Names: []string{"/web"}}.Name())
+	assert.Equal(t, "", Container{}.Name())
+}
+
+func TestStackFromLabels(t *testing.T) {
+	tests := []struct {
+		labels   map[string]string
+		expected string
+	}{
+		{map[string]string{StackNamespaceLabel: "swarm-stack"}, "swarm-stack"},
+		{map[string]string{ComposeProjectLabel: "compose-stack"}, "compose-stack"},
+		{map[string]string{StackNamespaceLabel: "swarm-stack", ComposeProjectLabel: "other"}, "swarm-stack"},
+		{nil, ""},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, StackFromLabels(test.labels))
+	}
+}
+
+func TestImage_IsDangling(t *testing.T) {
+	assert.True(t, Image{}.IsDangling())
+	assert.True(t, Image{RepoTags: []string{"<none>:<none>"}}.IsDangling())
+	assert.False(t, Image{RepoTags: []string{"nginx:1.27"}}.IsDangling())
+}
+
+func TestContainer_ExitedSuccessfully(t *testing.T) {
+	assert.True(t, Container{State: "exited", Status: "Exited (0) 5 seconds ago"}.ExitedSuccessfully())
+	assert.False(t, Container{State: "exited", Status: "Exited (1) 5 seconds ago"}.ExitedSuccessfully())
+	assert.False(t, Container{State: "running", Status: "Up 2 minutes"}.ExitedSuccessfully())
+}
@@ -0,0 +1,51 @@
+package types
+
+type DockerInfo struct {
+	ID              string    `json:"ID"`
+	Name            string    `json:"Name"`
+	ServerVersion   string    `json:"ServerVersion"`
+	OperatingSystem string    `json:"OperatingSystem"`
+	NCPU            int       `json:"NCPU"`
+	MemTotal        int64     `json:"MemTotal"`
+	Swarm           SwarmInfo `json:"Swarm"`
+}
+
+type SwarmInfo struct {
+	NodeID           string       `json:"NodeID"`
+	NodeAddr         string       `json:"NodeAddr"`
+	LocalNodeState   string       `json:"LocalNodeState"`
+	ControlAvailable bool         `json:"ControlAvailable"`
+	Cluster          *ClusterInfo `json:"Cluster,omitempty"`
+}
+
+type ClusterInfo struct {
+	ID string `json:"ID"`
+}
+
+const (
+	StackNamespaceLabel = "com.docker.stack.namespace"
+	ComposeProjectLabel = "com.docker.compose.project"
+)
+
+func StackFromLabels(labels map[string]string) string {
+	if stack := labels[StackNamespaceLabel]; stack != "" {
+		return stack
+	}
+	return labels[ComposeProjectLabel]
+}
+
+type AgentMetadata struct {
+	// Set by the Portainer agent on resources aggregated from every swarm node
+	Agent *AgentNode `json:"Agent,omitempty"`
+}
+
+type AgentNode struct {
+	NodeName string `json:"NodeName"`
+}
+
+func (m *AgentMetadata) nodeName() string {
+	if m == nil || m.Agent == nil {
+		return ""
+	}
+	return m.Agent.NodeName
+}
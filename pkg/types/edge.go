@@ -0,0 +1,245 @@
+package types
+
+import "fmt"
+
+type EdgeStackDeploymentType int
+
+const (
+	EdgeStackDeploymentCompose    EdgeStackDeploymentType = 0
+	EdgeStackDeploymentKubernetes EdgeStackDeploymentType = 1
+)
+
+type EdgeStackStatusType int
+
+const (
+	EdgeStackStatusPending EdgeStackStatusType = iota
+	EdgeStackStatusDeploymentReceived
+	EdgeStackStatusError
+	EdgeStackStatusAcknowledged
+	EdgeStackStatusRemoved
+	EdgeStackStatusRemoteUpdateSuccess
+	EdgeStackStatusImagesPulled
+	EdgeStackStatusRunning
+	EdgeStackStatusDeploying
+	EdgeStackStatusRemoving
+	EdgeStackStatusPausedDeploying
+	EdgeStackStatusRollingBack
+	EdgeStackStatusRolledBack
+	EdgeStackStatusCompleted
+)
+
+type EdgeStack struct {
+	ID             int                     `json:"Id"`
+	Name           string                  `json:"Name"`
+	Status         map[int]EdgeStackStatus `json:"Status"`
+	CreationDate   int64                   `json:"CreationDate"`
+	EdgeGroups     []int                   `json:"EdgeGroups"`
+	EntryPoint     string                  `json:"EntryPoint,omitempty"`
+	Version        int                     `json:"Version"`
+	DeploymentType EdgeStackDeploymentType `json:"DeploymentType"`
+	GitConfig      *GitConfig              `json:"GitConfig,omitempty"`
+}
+
+type EdgeStackStatus struct {
+	Status     []EdgeStackDeploymentStatus `json:"Status"`
+	EndpointID int                         `json:"EndpointID"`
+}
+
+type EdgeStackDeploymentStatus struct {
+	Type  EdgeStackStatusType `json:"Type"`
+	Error string              `json:"Error,omitempty"`
+	Time  int64               `json:"Time"`
+}
+
+type EdgeGroup struct {
+	ID           int    `json:"Id"`
+	Name         string `json:"Name"`
+	Dynamic      bool   `json:"Dynamic"`
+	TagIDs       []int  `json:"TagIds"`
+	Endpoints    []int  `json:"Endpoints"`
+	PartialMatch bool   `json:"PartialMatch"`
+	HasEdgeStack bool   `json:"HasEdgeStack"`
+	HasEdgeJob   bool   `json:"HasEdgeJob"`
+}
+
+type EdgeStackDetails struct {
+	EdgeStack      `yaml:",inline"`
+	EdgeGroupNames []string `json:"EdgeGroupNames,omitempty"`
+}
+
+type EdgeStackEndpointStatus struct {
+	EndpointID   int    `json:"EndpointId"`
+	EndpointName string `json:"EndpointName"`
+	Status       string `json:"Status"`
+	Error        string `json:"Error,omitempty"`
+	Time         int64  `json:"Time,omitempty"`
+}
+
+type EdgeStackCreateFromStringRequest struct {
+	Name             string                  `json:"Name"`
+	StackFileContent string                  `json:"StackFileContent"`
+	EdgeGroups       []int                   `json:"EdgeGroups"`
+	DeploymentType   EdgeStackDeploymentType `json:"DeploymentType"`
+}
+
+type EdgeStackCreateFromGitRequest struct {
+	Name                     string                  `json:"Name"`
+	RepositoryURL            string                  `json:"RepositoryURL"`
+	RepositoryReferenceName  string                  `json:"RepositoryReferenceName,omitempty"`
+	RepositoryAuthentication bool                    `json:"RepositoryAuthentication"`
+	RepositoryUsername       string                  `json:"RepositoryUsername,omitempty"`
+	RepositoryPassword       string                  `json:"RepositoryPassword,omitempty"`
+	FilePathInRepository     string                  `json:"FilePathInRepository"`
+	EdgeGroups               []int                   `json:"EdgeGroups"`
+	DeploymentType           EdgeStackDeploymentType `json:"DeploymentType"`
+	TLSSkipVerify            bool                    `json:"TLSSkipVerify"`
+}
+
+type EdgeStackUpdateRequest struct {
+	StackFileContent string                  `json:"StackFileContent"`
+	EdgeGroups       []int                   `json:"EdgeGroups"`
+	DeploymentType   EdgeStackDeploymentType `json:"DeploymentType"`
+	UpdateVersion    bool                    `json:"UpdateVersion"`
+}
+
+type EdgeStackFileResponse struct {
+	StackFileContent string `json:"StackFileContent"`
+}
+
+func (t EdgeStackDeploymentType) String() string {
+	switch t {
+	case EdgeStackDeploymentCompose:
+		return "compose"
+	case EdgeStackDeploymentKubernetes:
+		return "kubernetes"
+	default:
+		return "unknown"
+	}
+}
+
+func ParseEdgeStackDeploymentType(value string) (EdgeStackDeploymentType, error) {
+	switch value {
+	case "compose", "":
+		return EdgeStackDeploymentCompose, nil
+	case "kubernetes", "k8s":
+		return EdgeStackDeploymentKubernetes, nil
+	default:
+		return 0, fmt.Errorf("invalid deployment type %q. Use compose or kubernetes", value)
+	}
+}
+
+func (t EdgeStackStatusType) String() string {
+	switch t {
+	case EdgeStackStatusPending:
+		return "pending"
+	case EdgeStackStatusDeploymentReceived:
+		return "received"
+	case EdgeStackStatusError:
+		return "error"
+	case EdgeStackStatusAcknowledged:
+		return "acknowledged"
+	case EdgeStackStatusRemoved:
+		return "removed"
+	case EdgeStackStatusRemoteUpdateSuccess:
+		return "updated"
+	case EdgeStackStatusImagesPulled:
+		return "images-pulled"
+	case EdgeStackStatusRunning:
+		return "running"
+	case EdgeStackStatusDeploying:
+		return "deploying"
+	case EdgeStackStatusRemoving:
+		return "removing"
+	case EdgeStackStatusPausedDeploying:
+		return "paused"
+	case EdgeStackStatusRollingBack:
+		return "rolling-back"
+	case EdgeStackStatusRolledBack:
+		return "rolled-back"
+	case EdgeStackStatusCompleted:
+		return "completed"
+	default:
+		return "unknown"
+	}
+}
+
+func (s EdgeStackStatus) Current() (EdgeStackDeploymentStatus, bool) {
+	// The agent appends a status on every step, the last one is the current state
+	if len(s.Status) == 0 {
+		return EdgeStackDeploymentStatus{}, false
+	}
+	return s.Status[len(s.Status)-1], true
+}
+
+func (s EdgeStack) FromGit() bool {
+	return s.GitConfig != nil && s.GitConfig.URL != ""
+}
+
+type EdgeJobLogsStatus int
+
+const (
+	EdgeJobLogsStatusIdle      EdgeJobLogsStatus = 1
+	EdgeJobLogsStatusPending   EdgeJobLogsStatus = 2
+	EdgeJobLogsStatusCollected EdgeJobLogsStatus = 3
+)
+
+type EdgeGroupDetails struct {
+	EdgeGroup     `yaml:",inline"`
+	TagNames      []string `json:"TagNames,omitempty"`
+	EndpointNames []string `json:"EndpointNames,omitempty"`
+}
+
+type EdgeGroupRequest struct {
+	Name         string `json:"Name"`
+	Dynamic      bool   `json:"Dynamic"`
+	TagIDs       []int  `json:"TagIDs"`
+	Endpoints    []int  `json:"Endpoints"`
+	PartialMatch bool   `json:"PartialMatch"`
+}
+
+type EdgeJob struct {
+	ID             int                         `json:"Id"`
+	Name           string                      `json:"Name"`
+	Created        int64                       `json:"Created"`
+	CronExpression string                      `json:"CronExpression"`
+	Recurring      bool                        `json:"Recurring"`
+	EdgeGroups     []int                       `json:"EdgeGroups"`
+	Endpoints      map[int]EdgeJobEndpointMeta `json:"Endpoints"`
+	Version        int                         `json:"Version"`
+}
+
+type EdgeJobEndpointMeta struct {
+	LogsStatus  EdgeJobLogsStatus `json:"LogsStatus"`
+	CollectLogs bool              `json:"CollectLogs"`
+}
+
+type EdgeJobTask struct {
+	ID         string            `json:"Id"`
+	EndpointID int               `json:"EndpointId"`
+	LogsStatus EdgeJobLogsStatus `json:"LogsStatus"`
+}
+
+type EdgeJobCreateRequest struct {
+	Name           string `json:"Name"`
+	CronExpression string `json:"CronExpression"`
+	Recurring      bool   `json:"Recurring"`
+	EdgeGroups     []int  `json:"EdgeGroups"`
+	Endpoints      []int  `json:"Endpoints"`
+	FileContent    string `json:"FileContent"`
+}
+
+type EdgeJobDetails struct {
+	EdgeJob        `yaml:",inline"`
+	EdgeGroupNames []string `json:"EdgeGroupNames,omitempty"`
+}
+
+type EdgeJobLogsResponse struct {
+	FileContent string `json:"FileContent"`
+}
+
+func (g EdgeGroup) Kind() string {
+	if g.Dynamic {
+		return "dynamic"
+	}
+	return "static"
+}
@@ -0,0 +1,138 @@
+package types
+
+type EndpointType int
+
+const (
+	EndpointTypeDocker                EndpointType = 1
+	EndpointTypeAgentOnDocker         EndpointType = 2
+	EndpointTypeAzure                 EndpointType = 3
+	EndpointTypeEdgeAgentOnDocker     EndpointType = 4
+	EndpointTypeKubernetesLocal       EndpointType = 5
+	EndpointTypeAgentOnKubernetes     EndpointType = 6
+	EndpointTypeEdgeAgentOnKubernetes EndpointType = 7
+)
+
+type EndpointStatus int
+
+const (
+	EndpointStatusUp   EndpointStatus = 1
+	EndpointStatusDown EndpointStatus = 2
+)
+
+type Endpoint struct {
+	ID        int              `json:"Id"`
+	Name      string           `json:"Name"`
+	Type      EndpointType     `json:"Type"`
+	URL       string           `json:"URL"`
+	PublicURL string           `json:"PublicURL,omitempty"`
+	GroupID   int              `json:"GroupId"`
+	Status    EndpointStatus   `json:"Status"`
+	TagIDs    []int            `json:"TagIds"`
+	EdgeID    string           `json:"EdgeID,omitempty"`
+	Snapshots []DockerSnapshot `json:"Snapshots,omitempty"`
+}
+
+type DockerSnapshot struct {
+	Time                  int64  `json:"Time"`
+	DockerVersion         string `json:"DockerVersion"`
+	Swarm                 bool   `json:"Swarm"`
+	TotalCPU              int    `json:"TotalCPU"`
+	TotalMemory           int64  `json:"TotalMemory"`
+	RunningContainerCount int    `json:"RunningContainerCount"`
+	StoppedContainerCount int    `json:"StoppedContainerCount"`
+	ServiceCount          int    `json:"ServiceCount"`
+	StackCount            int    `json:"StackCount"`
+}
+
+type EndpointGroup struct {
+	ID          int    `json:"Id"`
+	Name        string `json:"Name"`
+	Description string `json:"Description,omitempty"`
+	TagIDs      []int  `json:"TagIds"`
+}
+
+type Tag struct {
+	ID             int          `json:"ID"`
+	Name           string       `json:"Name"`
+	Endpoints      map[int]bool `json:"Endpoints,omitempty"`
+	EndpointGroups map[int]bool `json:"EndpointGroups,omitempty"`
+}
+
+type TagCreateRequest struct {
+	Name string `json:"Name"`
+}
+
+type EndpointGroupCreateRequest struct {
+	Name        string `json:"Name"`
+	Description string `json:"Description,omitempty"`
+	TagIDs      []int  `json:"TagIDs"`
+}
+
+type EndpointGroupDetails struct {
+	EndpointGroup `yaml:",inline"`
+	TagNames      []string `json:"TagNames,omitempty"`
+	Endpoints     []string `json:"Endpoints,omitempty"`
+}
+
+type EndpointFilters struct {
+	Search string `json:"search,omitempty"`
+}
+
+type EndpointDetails struct {
+	Endpoint  `yaml:",inline"`
+	GroupName string   `json:"GroupName,omitempty"`
+	TagNames  []string `json:"TagNames,omitempty"`
+	SwarmID   string   `json:"SwarmId,omitempty"`
+}
+
+func (et EndpointType) String() string {
+	switch et {
+	case EndpointTypeDocker:
+		return "docker"
+	case EndpointTypeAgentOnDocker:
+		return "docker-agent"
+	case EndpointTypeAzure:
+		return "azure"
+	case EndpointTypeEdgeAgentOnDocker:
+		return "docker-edge-agent"
+	case EndpointTypeKubernetesLocal:
+		return "kubernetes"
+	case EndpointTypeAgentOnKubernetes:
+		return "kubernetes-agent"
+	case EndpointTypeEdgeAgentOnKubernetes:
+		return "kubernetes-edge-agent"
+	default:
+		return "unknown"
+	}
+}
+
+func (es EndpointStatus) String() string {
+	switch es {
+	case EndpointStatusUp:
+		return "up"
+	case EndpointStatusDown:
+		return "down"
+	default:
+		return "unknown"
+	}
+}
+
+func (e Endpoint) IsDocker() bool {
+	switch e.Type {
+	case EndpointTypeDocker, EndpointTypeAgentOnDocker, EndpointTypeEdgeAgentOnDocker:
+		return true
+	default:
+		return false
+	}
+}
+
+func (e Endpoint) IsDockerAgent() bool {
+	return e.Type == EndpointTypeAgentOnDocker || e.Type == EndpointTypeEdgeAgentOnDocker
+}
+
+func (e Endpoint) IsSwarm() bool {
+	if !e.IsDocker() || len(e.Snapshots) == 0 {
+		return false
+	}
+	return e.Snapshots[len(e.Snapshots)-1].Swarm
+}
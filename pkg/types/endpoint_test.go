@@ -0,0 +1,45 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEndpointType_String(t *testing.T) {
+	tests := []struct {
+		endpointType EndpointType
+		expected     string
+	}{
+		{EndpointTypeDocker, "docker"},
+		{EndpointTypeAgentOnDocker, "docker-agent"},
+		{EndpointTypeEdgeAgentOnKubernetes, "kubernetes-edge-agent"},
+		{EndpointType(999), "unknown"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, test.endpointType.String())
+	}
+}
+
+func TestEndpointStatus_String(t *testing.T) {
+	assert.Equal(t, "up", EndpointStatusUp.String())
+	assert.Equal(t, "down", EndpointStatusDown.String())
+	assert.Equal(t, "unknown", EndpointStatus(0).String())
+}
+
+func TestEndpoint_IsSwarm(t *testing.T) {
+	tests := []struct {
+		endpoint Endpoint
+		expected bool
+	}{
+		{Endpoint{Type: EndpointTypeAgentOnDocker, Snapshots: []DockerSnapshot{{Swarm: true}}}, true},
+		{Endpoint{Type: EndpointTypeDocker, Snapshots: []DockerSnapshot{{Swarm: false}}}, false},
+		{Endpoint{Type: EndpointTypeDocker}, false},
+		{Endpoint{Type: EndpointTypeKubernetesLocal, Snapshots: []DockerSnapshot{{Swarm: true}}}, false},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, test.endpoint.IsSwarm())
+	}
+}
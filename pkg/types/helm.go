@@ -0,0 +1,79 @@
+package types
+
+type HelmRepositoryList struct {
+	GlobalRepository string               `json:"GlobalRepository"`
+	UserRepositories []HelmUserRepository `json:"UserRepositories"`
+}
+
+type HelmUserRepository struct {
+	ID     int    `json:"Id"`
+	UserID int    `json:"UserId"`
+	URL    string `json:"URL"`
+}
+
+type HelmRepositoryCreateRequest struct {
+	URL string `json:"url"`
+}
+
+type HelmRepository struct {
+	ID     int    `json:"Id,omitempty"`
+	URL    string `json:"URL"`
+	Global bool   `json:"Global"`
+}
+
+type HelmChartIndex struct {
+	Entries map[string][]HelmChartVersion `json:"entries"`
+}
+
+type HelmChartVersion struct {
+	Name        string `json:"name"`
+	Version     string `json:"version"`
+	AppVersion  string `json:"appVersion,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+type HelmRelease struct {
+	Name       string `json:"name"`
+	Namespace  string `json:"namespace"`
+	Revision   string `json:"revision"`
+	Updated    string `json:"updated"`
+	Status     string `json:"status"`
+	Chart      string `json:"chart"`
+	AppVersion string `json:"app_version"`
+}
+
+type HelmInstallRequest struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Chart     string `json:"chart"`
+	Repo      string `json:"repo"`
+	Version   string `json:"version,omitempty"`
+	Values    string `json:"values,omitempty"`
+}
+
+type HelmReleaseDetails struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Version   int    `json:"version"`
+	Info      struct {
+		Status string `json:"status"`
+	} `json:"info"`
+	Chart struct {
+		Metadata struct {
+			Name       string `json:"name"`
+			Version    string `json:"version"`
+			AppVersion string `json:"appVersion"`
+		} `json:"metadata"`
+	} `json:"chart"`
+}
+
+func (l HelmRepositoryList) Repositories() []HelmRepository {
+	var repositories []HelmRepository
+	if l.GlobalRepository != "" {
+		repositories = append(repositories, HelmRepository{URL: l.GlobalRepository, Global: true})
+	}
+	for _, repository := range l.UserRepositories {
+		repositories = append(repositories, HelmRepository{ID: repository.ID, URL: repository.URL})
+	}
+	return repositories
+}
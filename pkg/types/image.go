@@ -0,0 +1,53 @@
+package types
+
+type Image struct {
+	ID          string            `json:"Id"`
+	ParentID    string            `json:"ParentId,omitempty"`
+	RepoTags    []string          `json:"RepoTags"`
+	RepoDigests []string          `json:"RepoDigests,omitempty"`
+	Created     int64             `json:"Created"`
+	Size        int64             `json:"Size"`
+	Labels      map[string]string `json:"Labels,omitempty"`
+}
+
+type ImageDetails struct {
+	Image  `yaml:",inline"`
+	UsedBy []string `json:"UsedBy,omitempty"`
+	Node   string   `json:"Node,omitempty"`
+}
+
+type ImageDeleteResponseItem struct {
+	Untagged string `json:"Untagged,omitempty"`
+	Deleted  string `json:"Deleted,omitempty"`
+}
+
+type ImagePruneReport struct {
+	ImagesDeleted  []ImageDeleteResponseItem `json:"ImagesDeleted"`
+	SpaceReclaimed int64                     `json:"SpaceReclaimed"`
+}
+
+type JSONMessage struct {
+	ID             string           `json:"id,omitempty"`
+	Status         string           `json:"status,omitempty"`
+	Progress       string           `json:"progress,omitempty"`
+	ProgressDetail *JSONProgress    `json:"progressDetail,omitempty"`
+	Error          string           `json:"error,omitempty"`
+	ErrorDetail    *JSONErrorDetail `json:"errorDetail,omitempty"`
+}
+
+type JSONProgress struct {
+	Current int64 `json:"current,omitempty"`
+	Total   int64 `json:"total,omitempty"`
+}
+
+type JSONErrorDetail struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+func (i Image) IsDangling() bool {
+	if len(i.RepoTags) == 0 {
+		return true
+	}
+	return len(i.RepoTags) == 1 && i.RepoTags[0] == "<none>:<none>"
+}
@@ -0,0 +1,130 @@
+package types
+
+import "slices"
+
+const KubernetesStackLabel = "io.portainer.kubernetes.application.stack"
+
+// Namespaces Portainer treats as system namespaces
+var KubernetesSystemNamespaces = []string{"kube-system", "kube-public", "kube-node-lease", "portainer"}
+
+type KubernetesObjectMeta struct {
+	Name              string            `json:"name"`
+	Namespace         string            `json:"namespace,omitempty"`
+	Labels            map[string]string `json:"labels,omitempty"`
+	CreationTimestamp string            `json:"creationTimestamp,omitempty"`
+	Generation        int64             `json:"generation,omitempty"`
+}
+
+type KubernetesNamespace struct {
+	APIVersion string                    `json:"apiVersion,omitempty"`
+	Kind       string                    `json:"kind,omitempty"`
+	Metadata   KubernetesObjectMeta      `json:"metadata"`
+	Status     KubernetesNamespaceStatus `json:"status,omitempty"`
+}
+
+type KubernetesNamespaceStatus struct {
+	Phase string `json:"phase,omitempty"`
+}
+
+type KubernetesNamespaceList struct {
+	Items []KubernetesNamespace `json:"items"`
+}
+
+type KubernetesPodTemplate struct {
+	Spec struct {
+		Containers []KubernetesContainer `json:"containers"`
+	} `json:"spec"`
+}
+
+type KubernetesContainer struct {
+	Name  string `json:"name"`
+	Image string `json:"image"`
+}
+
+type KubernetesWorkloadSpec struct {
+	Replicas *int                  `json:"replicas,omitempty"`
+	Template KubernetesPodTemplate `json:"template"`
+}
+
+type KubernetesWorkloadStatus struct {
+	ObservedGeneration int64 `json:"observedGeneration"`
+	Replicas           int   `json:"replicas"`
+	ReadyReplicas      int   `json:"readyReplicas"`
+	UpdatedReplicas    int   `json:"updatedReplicas"`
+}
+
+type KubernetesDeployment struct {
+	Metadata KubernetesObjectMeta     `json:"metadata"`
+	Spec     KubernetesWorkloadSpec   `json:"spec"`
+	Status   KubernetesWorkloadStatus `json:"status"`
+}
+
+type KubernetesStatefulSet struct {
+	Metadata KubernetesObjectMeta     `json:"metadata"`
+	Spec     KubernetesWorkloadSpec   `json:"spec"`
+	Status   KubernetesWorkloadStatus `json:"status"`
+}
+
+type KubernetesDaemonSet struct {
+	Metadata KubernetesObjectMeta   `json:"metadata"`
+	Spec     KubernetesWorkloadSpec `json:"spec"`
+	Status   struct {
+		ObservedGeneration     int64 `json:"observedGeneration"`
+		CurrentNumberScheduled int   `json:"currentNumberScheduled"`
+		DesiredNumberScheduled int   `json:"desiredNumberScheduled"`
+		NumberReady            int   `json:"numberReady"`
+		UpdatedNumberScheduled int   `json:"updatedNumberScheduled"`
+	} `json:"status"`
+}
+
+type KubernetesApplication struct {
+	Name      string `json:"Name"`
+	Namespace string `json:"Namespace"`
+	Kind      string `json:"Kind"`
+	Image     string `json:"Image,omitempty"`
+	Ready     int    `json:"Ready"`
+	Desired   int    `json:"Desired"`
+	Updated   int    `json:"Updated"`
+	Current   int    `json:"Current"`
+	Stack     string `json:"Stack,omitempty"`
+	Created   string `json:"Created,omitempty"`
+
+	Generation         int64 `json:"Generation,omitempty"`
+	ObservedGeneration int64 `json:"ObservedGeneration,omitempty"`
+}
+
+func (a KubernetesApplication) RolledOut() bool {
+	// Like kubectl rollout status: the controller has seen the latest spec, every
+	// pod runs it and is ready, and no pod of the previous revision is left.
+	// Ready alone still counts the pods of the old revision during a rollout.
+	return a.ObservedGeneration >= a.Generation &&
+		a.Updated == a.Desired && a.Ready == a.Desired && a.Current == a.Desired
+}
+
+func (n KubernetesNamespace) IsSystem() bool {
+	return slices.Contains(KubernetesSystemNamespaces, n.Metadata.Name)
+}
+
+func (e Endpoint) IsKubernetes() bool {
+	switch e.Type {
+	case EndpointTypeKubernetesLocal, EndpointTypeAgentOnKubernetes, EndpointTypeEdgeAgentOnKubernetes:
+		return true
+	default:
+		return false
+	}
+}
+
+func (t KubernetesPodTemplate) Image() string {
+	if len(t.Spec.Containers) == 0 {
+		return ""
+	}
+	return t.Spec.Containers[0].Image
+}
+
+func (s KubernetesWorkloadSpec) DesiredReplicas() int {
+	// Kubernetes defaults to a single replica when the field is omitted
+	if s.Replicas == nil {
+		return 1
+	}
+	return *s.Replicas
+}
@@ -0,0 +1,67 @@
+package types
+
+type Network struct {
+	ID         string                      `json:"Id"`
+	Name       string                      `json:"Name"`
+	Created    string                      `json:"Created"`
+	Scope      string                      `json:"Scope"`
+	Driver     string                      `json:"Driver"`
+	Internal   bool                        `json:"Internal"`
+	Attachable bool                        `json:"Attachable"`
+	Ingress    bool                        `json:"Ingress"`
+	IPAM       NetworkIPAM                 `json:"IPAM"`
+	Labels     map[string]string           `json:"Labels,omitempty"`
+	Options    map[string]string           `json:"Options,omitempty"`
+	Containers map[string]NetworkContainer `json:"Containers,omitempty"`
+	Portainer  *AgentMetadata              `json:"Portainer,omitempty"`
+}
+
+type NetworkIPAM struct {
+	Driver string              `json:"Driver,omitempty"`
+	Config []NetworkIPAMConfig `json:"Config,omitempty"`
+}
+
+type NetworkIPAMConfig struct {
+	Subnet  string `json:"Subnet,omitempty"`
+	Gateway string `json:"Gateway,omitempty"`
+	IPRange string `json:"IPRange,omitempty"`
+}
+
+type NetworkContainer struct {
+	Name        string `json:"Name"`
+	IPv4Address string `json:"IPv4Address,omitempty"`
+	IPv6Address string `json:"IPv6Address,omitempty"`
+}
+
+type NetworkDetails struct {
+	Network `yaml:",inline"`
+	Unused  bool `json:"Unused"`
+}
+
+type NetworkCreateRequest struct {
+	Name       string            `json:"Name"`
+	Driver     string            `json:"Driver,omitempty"`
+	Internal   bool              `json:"Internal,omitempty"`
+	Attachable bool              `json:"Attachable,omitempty"`
+	IPAM       *NetworkIPAM      `json:"IPAM,omitempty"`
+	Labels     map[string]string `json:"Labels,omitempty"`
+	Options    map[string]string `json:"Options,omitempty"`
+}
+
+type NetworkCreateResponse struct {
+	ID      string `json:"Id"`
+	Warning string `json:"Warning,omitempty"`
+}
+
+type NetworkFilters struct {
+	Stack    string `json:"stack,omitempty"`
+	Dangling bool   `json:"dangling,omitempty"`
+}
+
+func (n Network) StackName() string {
+	return StackFromLabels(n.Labels)
+}
+
+func (n Network) NodeName() string {
+	return n.Portainer.nodeName()
+}
@@ -0,0 +1,77 @@
+package types
+
+type NodeAvailability string
+
+const (
+	NodeAvailabilityActive NodeAvailability = "active"
+	NodeAvailabilityPause  NodeAvailability = "pause"
+	NodeAvailabilityDrain  NodeAvailability = "drain"
+)
+
+type Node struct {
+	ID            string          `json:"ID"`
+	Version       ObjectVersion   `json:"Version"`
+	CreatedAt     string          `json:"CreatedAt"`
+	UpdatedAt     string          `json:"UpdatedAt"`
+	Spec          NodeSpec        `json:"Spec"`
+	Description   NodeDescription `json:"Description"`
+	Status        NodeStatus      `json:"Status"`
+	ManagerStatus *ManagerStatus  `json:"ManagerStatus,omitempty"`
+}
+
+type NodeSpec struct {
+	Name         string            `json:"Name,omitempty"`
+	Labels       map[string]string `json:"Labels"`
+	Role         string            `json:"Role"`
+	Availability NodeAvailability  `json:"Availability"`
+}
+
+type NodeDescription struct {
+	Hostname  string        `json:"Hostname"`
+	Platform  NodePlatform  `json:"Platform"`
+	Resources NodeResources `json:"Resources"`
+	Engine    NodeEngine    `json:"Engine"`
+}
+
+type NodePlatform struct {
+	Architecture string `json:"Architecture"`
+	OS           string `json:"OS"`
+}
+
+type NodeResources struct {
+	NanoCPUs    int64 `json:"NanoCPUs"`
+	MemoryBytes int64 `json:"MemoryBytes"`
+}
+
+type NodeEngine struct {
+	EngineVersion string            `json:"EngineVersion"`
+	Labels        map[string]string `json:"Labels,omitempty"`
+}
+
+type NodeStatus struct {
+	State   string `json:"State"`
+	Message string `json:"Message,omitempty"`
+	Addr    string `json:"Addr"`
+}
+
+type ManagerStatus struct {
+	Leader       bool   `json:"Leader,omitempty"`
+	Reachability string `json:"Reachability"`
+	Addr         string `json:"Addr"`
+}
+
+type NodeDetails struct {
+	Node         `yaml:",inline"`
+	RunningTasks int `json:"RunningTasks"`
+}
+
+func (n Node) ManagerRole() string {
+	switch {
+	case n.ManagerStatus == nil:
+		return ""
+	case n.ManagerStatus.Leader:
+		return "leader"
+	default:
+		return n.ManagerStatus.Reachability
+	}
+}
@@ -0,0 +1,93 @@
+package types
+
+type RegistryType int
+
+const (
+	RegistryTypeQuay      RegistryType = 1
+	RegistryTypeAzure     RegistryType = 2
+	RegistryTypeCustom    RegistryType = 3
+	RegistryTypeGitlab    RegistryType = 4
+	RegistryTypeProGet    RegistryType = 5
+	RegistryTypeDockerHub RegistryType = 6
+	RegistryTypeECR       RegistryType = 7
+	RegistryTypeGithub    RegistryType = 8
+)
+
+type Registry struct {
+	ID               int                            `json:"Id"`
+	Name             string                         `json:"Name"`
+	Type             RegistryType                   `json:"Type"`
+	URL              string                         `json:"URL"`
+	BaseURL          string                         `json:"BaseURL,omitempty"`
+	Authentication   bool                           `json:"Authentication"`
+	Username         string                         `json:"Username,omitempty"`
+	Ecr              *RegistryEcrData               `json:"Ecr,omitempty"`
+	RegistryAccesses map[int]RegistryAccessPolicies `json:"RegistryAccesses,omitempty"`
+}
+
+type RegistryEcrData struct {
+	Region string `json:"Region"`
+}
+
+type RegistryAccessPolicies struct {
+	UserAccessPolicies map[int]AccessPolicy `json:"UserAccessPolicies"`
+	TeamAccessPolicies map[int]AccessPolicy `json:"TeamAccessPolicies"`
+	Namespaces         []string             `json:"Namespaces,omitempty"`
+}
+
+type AccessPolicy struct {
+	RoleID int `json:"RoleId"`
+}
+
+type RegistryCreateRequest struct {
+	Name           string           `json:"Name"`
+	Type           RegistryType     `json:"Type"`
+	URL            string           `json:"URL"`
+	BaseURL        string           `json:"BaseURL,omitempty"`
+	Authentication bool             `json:"Authentication"`
+	Username       string           `json:"Username,omitempty"`
+	Password       string           `json:"Password,omitempty"`
+	Ecr            *RegistryEcrData `json:"Ecr,omitempty"`
+}
+
+type RegistryUpdateRequest struct {
+	Name           *string          `json:"Name,omitempty"`
+	URL            *string          `json:"URL,omitempty"`
+	BaseURL        *string          `json:"BaseURL,omitempty"`
+	Authentication *bool            `json:"Authentication,omitempty"`
+	Username       *string          `json:"Username,omitempty"`
+	Password       *string          `json:"Password,omitempty"`
+	Ecr            *RegistryEcrData `json:"Ecr,omitempty"`
+}
+
+func ParseRegistryType(value string) (RegistryType, bool) {
+	for rt := RegistryTypeQuay; rt <= RegistryTypeGithub; rt++ {
+		if rt.String() == value {
+			return rt, true
+		}
+	}
+	return 0, false
+}
+
+func (rt RegistryType) String() string {
+	switch rt {
+	case RegistryTypeQuay:
+		return "quay"
+	case RegistryTypeAzure:
+		return "azure"
+	case RegistryTypeCustom:
+		return "custom"
+	case RegistryTypeGitlab:
+		return "gitlab"
+	case RegistryTypeProGet:
+		return "proget"
+	case RegistryTypeDockerHub:
+		return "dockerhub"
+	case RegistryTypeECR:
+		return "ecr"
+	case RegistryTypeGithub:
+		return "github"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,47 @@
+package types
+
+import "fmt"
+
+type ResourceControlCreateRequest struct {
+	ResourceID         string   `json:"ResourceID"`
+	Type               string   `json:"Type"`
+	Public             bool     `json:"Public"`
+	AdministratorsOnly bool     `json:"AdministratorsOnly"`
+	Users              []int    `json:"Users"`
+	Teams              []int    `json:"Teams"`
+	SubResourceIDs     []string `json:"SubResourceIDs"`
+}
+
+type ResourceControlUpdateRequest struct {
+	Public             bool  `json:"Public"`
+	AdministratorsOnly bool  `json:"AdministratorsOnly"`
+	Users              []int `json:"Users"`
+	Teams              []int `json:"Teams"`
+}
+
+type StackAccess struct {
+	StackID           int      `json:"StackId"`
+	StackName         string   `json:"StackName"`
+	ResourceControlID int      `json:"ResourceControlId,omitempty"`
+	Ownership         string   `json:"Ownership"`
+	Users             []string `json:"Users,omitempty"`
+	Teams             []string `json:"Teams,omitempty"`
+}
+
+func (rc *ResourceControl) Ownership() string {
+	switch {
+	// Resources without a resource control are only visible to administrators
+	case rc == nil || rc.AdministratorsOnly:
+		return "administrators"
+	case rc.Public:
+		return "public"
+	case len(rc.TeamAccesses) == 0 && len(rc.UserAccesses) == 1:
+		return "private"
+	default:
+		return "restricted"
+	}
+}
+
+func StackResourceID(endpointID int, name string) string {
+	return fmt.Sprintf("%d_%s", endpointID, name)
+}
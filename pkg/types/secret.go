@@ -0,0 +1,71 @@
+package types
+
+type Secret struct {
+	ID        string        `json:"ID"`
+	Version   ObjectVersion `json:"Version"`
+	CreatedAt string        `json:"CreatedAt"`
+	UpdatedAt string        `json:"UpdatedAt"`
+	Spec      SecretSpec    `json:"Spec"`
+}
+
+type SecretSpec struct {
+	Name   string            `json:"Name"`
+	Labels map[string]string `json:"Labels,omitempty"`
+	// Encoded as base64 by encoding/json, as the Docker API expects
+	Data []byte `json:"Data,omitempty"`
+}
+
+type SwarmConfig struct {
+	ID        string          `json:"ID"`
+	Version   ObjectVersion   `json:"Version"`
+	CreatedAt string          `json:"CreatedAt"`
+	UpdatedAt string          `json:"UpdatedAt"`
+	Spec      SwarmConfigSpec `json:"Spec"`
+}
+
+type SwarmConfigSpec struct {
+	Name   string            `json:"Name"`
+	Labels map[string]string `json:"Labels,omitempty"`
+	Data   []byte            `json:"Data,omitempty"`
+}
+
+type SecretDetails struct {
+	Secret `yaml:",inline"`
+	UsedBy []string `json:"UsedBy,omitempty"`
+}
+
+type SwarmConfigDetails struct {
+	SwarmConfig `yaml:",inline"`
+	UsedBy      []string `json:"UsedBy,omitempty"`
+}
+
+type SecretReference struct {
+	File       *SecretReferenceFile `json:"File,omitempty"`
+	SecretID   string               `json:"SecretID"`
+	SecretName string               `json:"SecretName"`
+}
+
+type ConfigReference struct {
+	File       *SecretReferenceFile `json:"File,omitempty"`
+	ConfigID   string               `json:"ConfigID"`
+	ConfigName string               `json:"ConfigName"`
+}
+
+type SecretReferenceFile struct {
+	Name string `json:"Name"`
+	UID  string `json:"UID,omitempty"`
+	GID  string `json:"GID,omitempty"`
+	Mode uint32 `json:"Mode,omitempty"`
+}
+
+type IDResponse struct {
+	ID string `json:"ID"`
+}
+
+func (s Secret) StackName() string {
+	return StackFromLabels(s.Spec.Labels)
+}
+
+func (c SwarmConfig) StackName() string {
+	return StackFromLabels(c.Spec.Labels)
+}
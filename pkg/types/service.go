@@ -0,0 +1,116 @@
+package types
+
+import "strings"
+
+type ObjectVersion struct {
+	Index uint64 `json:"Index"`
+}
+
+type Service struct {
+	ID            string               `json:"ID"`
+	Version       ObjectVersion        `json:"Version"`
+	CreatedAt     string               `json:"CreatedAt"`
+	UpdatedAt     string               `json:"UpdatedAt"`
+	Spec          ServiceSpec          `json:"Spec"`
+	UpdateStatus  *ServiceUpdateStatus `json:"UpdateStatus,omitempty"`
+	ServiceStatus *ServiceStatus       `json:"ServiceStatus,omitempty"`
+}
+
+type ServiceSpec struct {
+	Name         string            `json:"Name"`
+	Labels       map[string]string `json:"Labels,omitempty"`
+	TaskTemplate TaskSpec          `json:"TaskTemplate"`
+	Mode         ServiceMode       `json:"Mode"`
+}
+
+type TaskSpec struct {
+	ContainerSpec ContainerSpec `json:"ContainerSpec"`
+	ForceUpdate   uint64        `json:"ForceUpdate"`
+}
+
+type ContainerSpec struct {
+	Image   string            `json:"Image"`
+	Labels  map[string]string `json:"Labels,omitempty"`
+	Env     []string          `json:"Env,omitempty"`
+	Secrets []SecretReference `json:"Secrets,omitempty"`
+	Configs []ConfigReference `json:"Configs,omitempty"`
+}
+
+type ServiceMode struct {
+	Replicated *ReplicatedService `json:"Replicated,omitempty"`
+	Global     *struct{}          `json:"Global,omitempty"`
+}
+
+type ReplicatedService struct {
+	Replicas *uint64 `json:"Replicas,omitempty"`
+}
+
+type ServiceUpdateStatus struct {
+	State       string `json:"State"`
+	StartedAt   string `json:"StartedAt,omitempty"`
+	CompletedAt string `json:"CompletedAt,omitempty"`
+	Message     string `json:"Message,omitempty"`
+}
+
+type ServiceStatus struct {
+	RunningTasks uint64 `json:"RunningTasks"`
+	DesiredTasks uint64 `json:"DesiredTasks"`
+}
+
+type ServiceFilters struct {
+	Stack string `json:"stack,omitempty"`
+}
+
+type Task struct {
+	ID           string     `json:"ID"`
+	ServiceID    string     `json:"ServiceID"`
+	NodeID       string     `json:"NodeID"`
+	Slot         int        `json:"Slot,omitempty"`
+	DesiredState string     `json:"DesiredState"`
+	Status       TaskStatus `json:"Status"`
+	Spec         TaskSpec   `json:"Spec"`
+}
+
+type TaskStatus struct {
+	Timestamp string `json:"Timestamp"`
+	State     string `json:"State"`
+	Message   string `json:"Message"`
+	Err       string `json:"Err,omitempty"`
+}
+
+type TaskFilters struct {
+	Service      string `json:"service,omitempty"`
+	Node         string `json:"node,omitempty"`
+	DesiredState string `json:"desiredState,omitempty"`
+}
+
+func (s Service) LastUpdateStarted() string {
+	if s.UpdateStatus == nil {
+		return ""
+	}
+	return s.UpdateStatus.StartedAt
+}
+
+func (s Service) Mode() string {
+	switch {
+	case s.Spec.Mode.Replicated != nil:
+		return "replicated"
+	case s.Spec.Mode.Global != nil:
+		return "global"
+	default:
+		return "unknown"
+	}
+}
+
+func (s Service) Image() string {
+	// Swarm pins images by digest; the tag is what users recognise
+	image := s.Spec.TaskTemplate.ContainerSpec.Image
+	if index := strings.Index(image, "@sha256:"); index > 0 {
+		return image[:index]
+	}
+	return image
+}
+
+func (s Service) StackName() string {
+	return StackFromLabels(s.Spec.Labels)
+}
@@ -0,0 +1,14 @@
+package types
+
+type Settings map[string]interface{}
+
+type SettingValue struct {
+	Key   string      `json:"key"`
+	Value interface{} `json:"value"`
+}
+
+type SettingChange struct {
+	Key string      `json:"key"`
+	Old interface{} `json:"old"`
+	New interface{} `json:"new"`
+}
@@ -5,6 +5,7 @@ type StackType int
 const (
 	StackTypeDockerCompose StackType = 1
 	StackTypeDockerSwarm   StackType = 2
+	StackTypeKubernetes    StackType = 3
 )
 
 type Stack struct {
@@ -23,7 +24,7 @@ type Stack struct {
 	UpdateDate      int64            `json:"UpdateDate"`
 	UpdatedBy       string           `json:"UpdatedBy"`
 	AdditionalFiles []string         `json:"AdditionalFiles,omitempty"`
-	AutoUpdate      interface{}      `json:"AutoUpdate,omitempty"`
+	AutoUpdate      *StackAutoUpdate `json:"AutoUpdate,omitempty"`
 	Option          interface{}      `json:"Option,omitempty"`
 	GitConfig       *GitConfig       `json:"GitConfig,omitempty"`
 	FromAppTemplate bool             `json:"FromAppTemplate"`
@@ -72,6 +73,14 @@ type GitAuth struct {
 	GitCredentialID int    `json:"GitCredentialID"`
 }
 
+type StackAutoUpdate struct {
+	Interval       string `json:"Interval,omitempty"`
+	Webhook        string `json:"Webhook,omitempty"`
+	ForceUpdate    bool   `json:"ForceUpdate"`
+	ForcePullImage bool   `json:"ForcePullImage"`
+	JobID          string `json:"JobID,omitempty"`
+}
+
 type StackFilters struct {
 	EndpointID int    `json:"EndpointId,omitempty"`
 	SwarmID    string `json:"SwarmId,omitempty"`
@@ -83,6 +92,8 @@ func (st StackType) String() string {
 		return "compose"
 	case StackTypeDockerSwarm:
 		return "swarm"
+	case StackTypeKubernetes:
+		return "kubernetes"
 	default:
 		return "unknown"
 	}
@@ -130,6 +141,43 @@ type StackCreateSwarmGitPayload struct {
 	FromAppTemplate          bool                `json:"fromAppTemplate,omitempty"`
 }
 
+type StackCreateComposeGitPayload struct {
+	Name                     string              `json:"name"`
+	RepositoryURL            string              `json:"repositoryURL"`
+	ComposeFile              string              `json:"composeFile,omitempty"`
+	RepositoryReferenceName  string              `json:"repositoryReferenceName,omitempty"`
+	RepositoryAuthentication bool                `json:"repositoryAuthentication,omitempty"`
+	RepositoryUsername       string              `json:"repositoryUsername,omitempty"`
+	RepositoryPassword       string              `json:"repositoryPassword,omitempty"`
+	Env                      []Pair              `json:"env,omitempty"`
+	AdditionalFiles          []string            `json:"additionalFiles,omitempty"`
+	AutoUpdate               *AutoUpdateSettings `json:"autoUpdate,omitempty"`
+	TLSSkipVerify            bool                `json:"tlsskipVerify,omitempty"`
+	FromAppTemplate          bool                `json:"fromAppTemplate,omitempty"`
+}
+
+type StackCreateKubernetesGitPayload struct {
+	StackName                string              `json:"stackName"`
+	Namespace                string              `json:"namespace"`
+	ComposeFormat            bool                `json:"composeFormat"`
+	RepositoryURL            string              `json:"repositoryURL"`
+	RepositoryReferenceName  string              `json:"repositoryReferenceName,omitempty"`
+	RepositoryAuthentication bool                `json:"repositoryAuthentication,omitempty"`
+	RepositoryUsername       string              `json:"repositoryUsername,omitempty"`
+	RepositoryPassword       string              `json:"repositoryPassword,omitempty"`
+	ManifestFile             string              `json:"manifestFile"`
+	AdditionalFiles          []string            `json:"additionalFiles,omitempty"`
+	AutoUpdate               *AutoUpdateSettings `json:"autoUpdate,omitempty"`
+	TLSSkipVerify            bool                `json:"tlsskipVerify,omitempty"`
+}
+
+type StackCreateKubernetesFilePayload struct {
+	StackName        string `json:"stackName"`
+	Namespace        string `json:"namespace"`
+	ComposeFormat    bool   `json:"composeFormat"`
+	StackFileContent string `json:"stackFileContent"`
+}
+
 type StackGitRedeployPayload struct {
 	Env                      []Pair `json:"env,omitempty"`
 	Prune                    bool   `json:"prune,omitempty"`
@@ -140,3 +188,18 @@ type StackGitRedeployPayload struct {
 	RepositoryUsername       string `json:"repositoryUsername,omitempty"`
 	StackName                string `json:"stackName,omitempty"`
 }
+
+type StackCreateSwarmStringPayload struct {
+	Name             string `json:"name"`
+	SwarmID          string `json:"swarmID"`
+	StackFileContent string `json:"stackFileContent"`
+	Env              []Pair `json:"env,omitempty"`
+	FromAppTemplate  bool   `json:"fromAppTemplate,omitempty"`
+}
+
+type StackCreateComposeStringPayload struct {
+	Name             string `json:"name"`
+	StackFileContent string `json:"stackFileContent"`
+	Env              []Pair `json:"env,omitempty"`
+	FromAppTemplate  bool   `json:"fromAppTemplate,omitempty"`
+}
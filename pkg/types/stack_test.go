@@ -13,6 +13,7 @@ func TestStackType_String(t *testing.T) {
 	}{
 		{StackTypeDockerCompose, "compose"},
 		{StackTypeDockerSwarm, "swarm"},
+		{StackTypeKubernetes, "kubernetes"},
 		{StackType(999), "unknown"},
 	}
 
@@ -149,3 +150,12 @@ func TestStackGitRedeployPayload_Creation(t *testing.T) {
 	assert.False(t, payload.PullImage)
 	assert.Equal(t, "test-stack", payload.StackName)
 }
+
+func TestResourceControl_Ownership(t *testing.T) {
+	var none *ResourceControl
+	assert.Equal(t, "administrators", none.Ownership())
+	assert.Equal(t, "administrators", (&ResourceControl{AdministratorsOnly: true}).Ownership())
+	assert.Equal(t, "public", (&ResourceControl{Public: true}).Ownership())
+	assert.Equal(t, "private", (&ResourceControl{UserAccesses: []UserAccess{{UserID: 1}}}).Ownership())
+	assert.Equal(t, "restricted", (&ResourceControl{TeamAccesses: []TeamAccess{{TeamID: 2}}}).Ownership())
+}
@@ -0,0 +1,45 @@
+package types
+
+type SystemStatus struct {
+	Version    string `json:"Version"`
+	InstanceID string `json:"InstanceID"`
+}
+
+type SystemVersion struct {
+	ServerVersion   string          `json:"ServerVersion"`
+	ServerEdition   string          `json:"ServerEdition"`
+	DatabaseVersion string          `json:"DatabaseVersion"`
+	LatestVersion   string          `json:"LatestVersion"`
+	UpdateAvailable bool            `json:"UpdateAvailable"`
+	Build           SystemBuildInfo `json:"Build"`
+}
+
+type SystemBuildInfo struct {
+	BuildNumber string `json:"BuildNumber"`
+	ImageTag    string `json:"ImageTag"`
+	GoVersion   string `json:"GoVersion"`
+	GitCommit   string `json:"GitCommit"`
+}
+
+type SystemInfo struct {
+	Platform   string `json:"platform"`
+	Agents     int    `json:"agents"`
+	EdgeAgents int    `json:"edgeAgents"`
+}
+
+type ServerStatus struct {
+	URL             string `json:"url"`
+	Version         string `json:"version"`
+	Edition         string `json:"edition,omitempty"`
+	InstanceID      string `json:"instanceId"`
+	DatabaseVersion string `json:"databaseVersion,omitempty"`
+	Platform        string `json:"platform,omitempty"`
+	Agents          int    `json:"agents"`
+	EdgeAgents      int    `json:"edgeAgents"`
+	LatestVersion   string `json:"latestVersion,omitempty"`
+	UpdateAvailable bool   `json:"updateAvailable"`
+	ResponseTimeMS  int64  `json:"responseTimeMs"`
+	SupportedRange  string `json:"supportedRange"`
+	Compatibility   string `json:"compatibility"`
+	Note            string `json:"note,omitempty"`
+}
@@ -0,0 +1,154 @@
+package types
+
+type TemplatePlatform int
+
+const (
+	TemplatePlatformLinux   TemplatePlatform = 1
+	TemplatePlatformWindows TemplatePlatform = 2
+)
+
+type CustomTemplate struct {
+	ID              int                      `json:"Id"`
+	Title           string                   `json:"Title"`
+	Description     string                   `json:"Description"`
+	Note            string                   `json:"Note,omitempty"`
+	Logo            string                   `json:"Logo,omitempty"`
+	Platform        TemplatePlatform         `json:"Platform"`
+	Type            StackType                `json:"Type"`
+	EntryPoint      string                   `json:"EntryPoint,omitempty"`
+	CreatedByUserID int                      `json:"CreatedByUserId"`
+	Variables       []CustomTemplateVariable `json:"Variables,omitempty"`
+	GitConfig       *GitConfig               `json:"GitConfig,omitempty"`
+	ResourceControl *ResourceControl         `json:"ResourceControl,omitempty"`
+}
+
+type CustomTemplateVariable struct {
+	Name         string `json:"name"`
+	Label        string `json:"label"`
+	DefaultValue string `json:"defaultValue,omitempty"`
+	Description  string `json:"description,omitempty"`
+}
+
+type CustomTemplateCreateRequest struct {
+	Title       string                   `json:"Title"`
+	Description string                   `json:"Description"`
+	Note        string                   `json:"Note,omitempty"`
+	Logo        string                   `json:"Logo,omitempty"`
+	FileContent string                   `json:"FileContent"`
+	Platform    TemplatePlatform         `json:"Platform"`
+	Type        StackType                `json:"Type"`
+	Variables   []CustomTemplateVariable `json:"Variables,omitempty"`
+}
+
+type CustomTemplateFileResponse struct {
+	FileContent string `json:"FileContent"`
+}
+
+func (p TemplatePlatform) String() string {
+	switch p {
+	case TemplatePlatformLinux:
+		return "linux"
+	case TemplatePlatformWindows:
+		return "windows"
+	default:
+		return "unknown"
+	}
+}
+
+func ParseTemplatePlatform(value string) (TemplatePlatform, bool) {
+	switch value {
+	case "linux":
+		return TemplatePlatformLinux, true
+	case "windows":
+		return TemplatePlatformWindows, true
+	default:
+		return 0, false
+	}
+}
+
+func ParseStackType(value string) (StackType, bool) {
+	switch value {
+	case "swarm":
+		return StackTypeDockerSwarm, true
+	case "compose", "standalone":
+		return StackTypeDockerCompose, true
+	case "kubernetes", "k8s":
+		return StackTypeKubernetes, true
+	default:
+		return 0, false
+	}
+}
+
+type AppTemplateType int
+
+const (
+	AppTemplateTypeContainer AppTemplateType = 1
+	AppTemplateTypeSwarm     AppTemplateType = 2
+	AppTemplateTypeCompose   AppTemplateType = 3
+)
+
+type AppTemplateList struct {
+	Version   string        `json:"version"`
+	Templates []AppTemplate `json:"templates"`
+}
+
+type AppTemplate struct {
+	ID                int                    `json:"id"`
+	Type              AppTemplateType        `json:"type"`
+	Title             string                 `json:"title"`
+	Description       string                 `json:"description"`
+	Categories        []string               `json:"categories,omitempty"`
+	Platform          string                 `json:"platform,omitempty"`
+	Logo              string                 `json:"logo,omitempty"`
+	Image             string                 `json:"image,omitempty"`
+	Repository        *AppTemplateRepository `json:"repository,omitempty"`
+	Env               []AppTemplateEnv       `json:"env,omitempty"`
+	Note              string                 `json:"note,omitempty"`
+	AdministratorOnly bool                   `json:"administrator_only,omitempty"`
+}
+
+type AppTemplateRepository struct {
+	URL       string `json:"url"`
+	StackFile string `json:"stackfile"`
+}
+
+type AppTemplateEnv struct {
+	Name        string                 `json:"name"`
+	Label       string                 `json:"label,omitempty"`
+	Description string                 `json:"description,omitempty"`
+	Default     string                 `json:"default,omitempty"`
+	Preset      bool                   `json:"preset,omitempty"`
+	Select      []AppTemplateEnvSelect `json:"select,omitempty"`
+}
+
+type AppTemplateEnvSelect struct {
+	Text    string `json:"text"`
+	Value   string `json:"value"`
+	Default bool   `json:"default,omitempty"`
+}
+
+func (t AppTemplateType) String() string {
+	switch t {
+	case AppTemplateTypeContainer:
+		return "container"
+	case AppTemplateTypeSwarm:
+		return "swarm"
+	case AppTemplateTypeCompose:
+		return "compose"
+	default:
+		return "unknown"
+	}
+}
+
+func ParseAppTemplateType(value string) (AppTemplateType, bool) {
+	switch value {
+	case "container":
+		return AppTemplateTypeContainer, true
+	case "swarm", "stack":
+		return AppTemplateTypeSwarm, true
+	case "compose", "standalone":
+		return AppTemplateTypeCompose, true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,20 @@
+package types
+
+type APIToken struct {
+	ID          int    `json:"id"`
+	UserID      int    `json:"userId"`
+	Description string `json:"description"`
+	Prefix      string `json:"prefix"`
+	DateCreated int64  `json:"dateCreated"`
+	LastUsed    int64  `json:"lastUsed"`
+}
+
+type APITokenCreateRequest struct {
+	Description string `json:"description"`
+	Password    string `json:"password,omitempty"`
+}
+
+type APITokenCreateResponse struct {
+	RawAPIKey string   `json:"rawAPIKey"`
+	APIKey    APIToken `json:"apiKey"`
+}
@@ -0,0 +1,98 @@
+package types
+
+type UserRole int
+
+const (
+	UserRoleAdmin    UserRole = 1
+	UserRoleStandard UserRole = 2
+)
+
+type MembershipRole int
+
+const (
+	MembershipRoleLeader MembershipRole = 1
+	MembershipRoleMember MembershipRole = 2
+)
+
+type User struct {
+	ID       int      `json:"Id"`
+	Username string   `json:"Username"`
+	Role     UserRole `json:"Role"`
+}
+
+type Team struct {
+	ID   int    `json:"Id"`
+	Name string `json:"Name"`
+}
+
+type TeamMembership struct {
+	ID     int            `json:"Id"`
+	UserID int            `json:"UserID"`
+	TeamID int            `json:"TeamID"`
+	Role   MembershipRole `json:"Role"`
+}
+
+type UserDetails struct {
+	User  `yaml:",inline"`
+	Teams []string `json:"Teams,omitempty"`
+}
+
+type TeamDetails struct {
+	Team    `yaml:",inline"`
+	Leaders []string `json:"Leaders,omitempty"`
+	Members []string `json:"Members,omitempty"`
+}
+
+type UserCreateRequest struct {
+	Username string   `json:"Username"`
+	Password string   `json:"Password"`
+	Role     UserRole `json:"Role"`
+}
+
+type UserUpdateRequest struct {
+	Role UserRole `json:"Role,omitempty"`
+}
+
+type TeamCreateRequest struct {
+	Name    string `json:"Name"`
+	Leaders []int  `json:"Leaders,omitempty"`
+}
+
+type TeamMembershipCreateRequest struct {
+	UserID int            `json:"UserID"`
+	TeamID int            `json:"TeamID"`
+	Role   MembershipRole `json:"Role"`
+}
+
+func (r UserRole) String() string {
+	switch r {
+	case UserRoleAdmin:
+		return "admin"
+	case UserRoleStandard:
+		return "standard"
+	default:
+		return "unknown"
+	}
+}
+
+func (r MembershipRole) String() string {
+	switch r {
+	case MembershipRoleLeader:
+		return "leader"
+	case MembershipRoleMember:
+		return "member"
+	default:
+		return "unknown"
+	}
+}
+
+func ParseUserRole(value string) (UserRole, bool) {
+	switch value {
+	case "admin", "administrator":
+		return UserRoleAdmin, true
+	case "standard", "user":
+		return UserRoleStandard, true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,42 @@
+package types
+
+type Volume struct {
+	Name       string            `json:"Name"`
+	Driver     string            `json:"Driver"`
+	Mountpoint string            `json:"Mountpoint"`
+	Scope      string            `json:"Scope"`
+	CreatedAt  string            `json:"CreatedAt,omitempty"`
+	Labels     map[string]string `json:"Labels,omitempty"`
+	Options    map[string]string `json:"Options,omitempty"`
+	Portainer  *AgentMetadata    `json:"Portainer,omitempty"`
+}
+
+type VolumeDetails struct {
+	Volume `yaml:",inline"`
+	Unused bool `json:"Unused"`
+}
+
+type VolumeListResponse struct {
+	Volumes  []Volume `json:"Volumes"`
+	Warnings []string `json:"Warnings,omitempty"`
+}
+
+type VolumeCreateRequest struct {
+	Name       string            `json:"Name"`
+	Driver     string            `json:"Driver,omitempty"`
+	DriverOpts map[string]string `json:"DriverOpts,omitempty"`
+	Labels     map[string]string `json:"Labels,omitempty"`
+}
+
+type VolumeFilters struct {
+	Stack    string `json:"stack,omitempty"`
+	Dangling bool   `json:"dangling,omitempty"`
+}
+
+func (v Volume) StackName() string {
+	return StackFromLabels(v.Labels)
+}
+
+func (v Volume) NodeName() string {
+	return v.Portainer.nodeName()
+}
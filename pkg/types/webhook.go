@@ -0,0 +1,49 @@
+package types
+
+type WebhookType int
+
+const (
+	WebhookTypeService   WebhookType = 1
+	WebhookTypeContainer WebhookType = 2
+)
+
+type Webhook struct {
+	ID         int         `json:"Id"`
+	Token      string      `json:"Token"`
+	ResourceID string      `json:"ResourceId"`
+	EndpointID int         `json:"EndpointId"`
+	RegistryID int         `json:"RegistryId"`
+	Type       WebhookType `json:"Type"`
+}
+
+type WebhookCreateRequest struct {
+	ResourceID  string      `json:"ResourceID"`
+	EndpointID  int         `json:"EndpointID"`
+	RegistryID  int         `json:"RegistryID,omitempty"`
+	WebhookType WebhookType `json:"WebhookType"`
+}
+
+type WebhookFilters struct {
+	EndpointID int    `json:"EndpointID,omitempty"`
+	ResourceID string `json:"ResourceID,omitempty"`
+}
+
+type WebhookDetails struct {
+	Type       string `json:"Type"`
+	ID         int    `json:"Id,omitempty"`
+	Token      string `json:"Token"`
+	Resource   string `json:"Resource"`
+	EndpointID int    `json:"EndpointId"`
+	URL        string `json:"URL"`
+}
+
+func (t WebhookType) String() string {
+	switch t {
+	case WebhookTypeService:
+		return "service"
+	case WebhookTypeContainer:
+		return "container"
+	default:
+		return "unknown"
+	}
+}